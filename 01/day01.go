@@ -1,80 +1,63 @@
 package main
 
 import (
-	"fmt"
+	"bytes"
+	"flag"
 	"os"
-	"strconv"
-	"strings"
-	"time"
-)
-
-// define an enum of type of values: "stops", "passes"
-type CountMethod string
 
-const (
-	Stops  CountMethod = "stops"
-	Passes CountMethod = "passes"
+	"advent-of-code-2025/01/day01"
+	"advent-of-code-2025/aoc"
 )
 
 func main() {
-	data, err := os.ReadFile("input.txt")
-	if err != nil {
-		panic(err)
+	var solver day01.Solver
+	flag.IntVar(&solver.Size, "size", day01.DefaultSize, "number of positions on the dial")
+	flag.IntVar(&solver.Start, "start", day01.DefaultStart, "position the dial points at first")
+	flag.BoolFunc("trace", "print every rotation of the dial to standard error", func(string) error {
+		solver.Trace = os.Stderr
+		return nil
+	})
+	rings := flag.String("rings", "", "simulate a lock with several rings instead, given as `size[:start],...` (e.g. 100:50,10,7:3)")
+	search := flag.String("search", "", "find single-instruction edits that reach a `goal` instead: open (finish on 0) or passes (most passes of 0)")
+	top := flag.Int("top", 10, "number of edits -search reports, or 0 for all")
+	aoc.ParseFlags()
+
+	if *search != "" {
+		aoc.Mode(1, func(input []byte) error {
+			rotations, err := day01.Parse(bytes.NewReader(input))
+			if err != nil {
+				return err
+			}
+			edits, err := day01.Search(solver.Size, solver.Start, rotations, day01.Goal(*search), *top)
+			if err != nil {
+				return err
+			}
+			dial, err := day01.NewDial(solver.Size, solver.Start)
+			if err != nil {
+				return err
+			}
+			return day01.WriteEdits(os.Stdout, dial, rotations, edits)
+		})
+		return
 	}
-	startTime := time.Now()
-
-	// Split the input data into lines
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-
-	// Starting position of the padlock
-	ringSize := 100
-	position := 50
-	countOfZeros := 0
-	countMethod := Passes
-	landedOnZero := 0
-	visitedZero := 0
-
-	for _, line := range lines {
-		if len(line) < 2 {
-			continue
-		}
-
-		// each line represents a number of clicks on a padlock, preceded by a direction (L or R)
-		direction := line[0]
-		clicks, err := strconv.Atoi(line[1:])
-		if err != nil {
-			panic(err)
-		}
-
-		unit := 1
-		if direction == 'L' {
-			unit = -1
-		}
-
-		adjustedClicks := clicks % ringSize
-		passesZero := clicks / ringSize
-		if (unit == -1 && position > 0 && position <= adjustedClicks) || position+unit*adjustedClicks >= ringSize {
-			passesZero += 1
-		}
-		newPosition := (position + unit*adjustedClicks + ringSize) % ringSize
-		// fmt.Printf("Direction: %c, Clicks: %d, From: %d To: %d PassesZero: %d\n", direction, clicks, position, newPosition, passesZero)
-
-		if newPosition == 0 {
-			landedOnZero += 1
-		}
-		visitedZero += passesZero
-
-		position = newPosition
 
-		if countMethod == Stops && position == 0 {
-			countOfZeros++
-		} else if countMethod == Passes {
-			countOfZeros += passesZero
-		}
+	if *rings != "" {
+		aoc.Mode(1, func(input []byte) error {
+			lock, err := day01.ParseLock(*rings)
+			if err != nil {
+				return err
+			}
+			rotations, err := day01.Parse(bytes.NewReader(input))
+			if err != nil {
+				return err
+			}
+			report, err := lock.Run(rotations)
+			if err != nil {
+				return err
+			}
+			return report.Write(os.Stdout, rotations)
+		})
+		return
 	}
-	fmt.Printf("Final position: %d\n", position)
-	fmt.Printf("landedOnZero: %d\n", landedOnZero)
-	fmt.Printf("visitedZero: %d\n", visitedZero)
-	fmt.Printf("Number of times landed on or passing 0: %d\n", countOfZeros)
-	fmt.Printf("Execution time: %s\n", time.Since(startTime))
+	aoc.Main(1, &solver)
 }
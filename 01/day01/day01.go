@@ -0,0 +1,149 @@
+package day01
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+// Rotation is one line of the input: turn the dial Clicks clicks to the
+// left (L) or right (R). On a lock with several rings, Ring picks the one to
+// turn, counting from 1.
+type Rotation struct {
+	Direction byte
+	Clicks    int
+	Ring      int
+}
+
+// Input is the list of rotations, in order
+type Input []Rotation
+
+// Parse reads one rotation per line, such as L68 or R14, skipping blank lines.
+// A rotation may start with the ring it turns, such as 2R45; otherwise it
+// turns ring 1.
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	rotations := make(Input, 0, len(lines))
+	for _, line := range lines {
+		if line.Text == "" {
+			continue
+		}
+		ring := 1
+		if c := line.Peek(); '0' <= c && c <= '9' {
+			start := line.Pos
+			if ring, err = line.Int(); err != nil {
+				return nil, err
+			}
+			if ring < 1 {
+				return nil, line.ErrorAt(start, "rings are numbered from 1")
+			}
+		}
+		direction := line.Peek()
+		if !line.Accept('L') && !line.Accept('R') {
+			return nil, line.Errorf("expected L or R")
+		}
+		start := line.Pos
+		clicks, err := line.Uint64()
+		if err != nil {
+			return nil, err
+		}
+		if clicks > math.MaxInt {
+			return nil, line.ErrorAt(start, "number %d out of range", clicks)
+		}
+		if err := line.End(); err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, Rotation{direction, int(clicks), ring})
+	}
+	return rotations, nil
+}
+
+const (
+	// DefaultSize is the number of positions on the puzzle's dial
+	DefaultSize = 100
+	// DefaultStart is where the puzzle's dial points first
+	DefaultStart = 50
+)
+
+type Solver struct {
+	// Size is the number of positions on the dial and Start where it points
+	// first; a zero Size means the puzzle's dial, DefaultSize and DefaultStart
+	Size, Start int
+	// Trace, when set, receives a line per rotation while part 1 runs
+	Trace io.Writer
+}
+
+// Part1 counts the rotations that leave the dial pointing at 0
+func (s Solver) Part1(input []byte) (any, error) {
+	counts, err := s.count(input, s.Trace)
+	return counts.Stops, err
+}
+
+// Part2 counts every click that points the dial at 0
+func (s Solver) Part2(input []byte) (any, error) {
+	counts, err := s.count(input, nil)
+	return counts.Passes, err
+}
+
+// Counts is how often a list of rotations pointed the dial at 0
+type Counts struct {
+	Stops  int // rotations that stopped on 0
+	Passes int // clicks that reached 0, whether the rotation stopped there or not
+}
+
+// Count turns the dial through every rotation, counting both ways it meets 0.
+// Each rotation is written to trace, when it isn't nil.
+func Count(dial *Dial, rotations Input, trace io.Writer) Counts {
+	var counts Counts
+	for i, rotation := range rotations {
+		from := dial.Position
+		passes := dial.Rotate(rotation.Direction, rotation.Clicks)
+
+		counts.Passes += passes
+		if dial.Position == 0 {
+			counts.Stops++
+		}
+		if trace != nil {
+			fmt.Fprintf(trace, "%d: %c%d %d -> %d passes=%d (total stops=%d passes=%d)\n",
+				i+1, rotation.Direction, rotation.Clicks, from, dial.Position, passes, counts.Stops, counts.Passes)
+		}
+	}
+	return counts
+}
+
+func (s Solver) count(input []byte, trace io.Writer) (Counts, error) {
+	rotations, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return Counts{}, err
+	}
+	for i, rotation := range rotations {
+		if rotation.Ring != 1 {
+			return Counts{}, fmt.Errorf("instruction %d turns ring %d, but the puzzle's lock has only one (see -rings)", i+1, rotation.Ring)
+		}
+	}
+	dial, err := s.dial()
+	if err != nil {
+		return Counts{}, err
+	}
+	return Count(dial, rotations, trace), nil
+}
+
+// Variant reports whether the solver turns a dial other than the puzzle's
+func (s Solver) Variant() bool {
+	return s.Size != 0 && (s.Size != DefaultSize || s.Start != DefaultStart)
+}
+
+// dial returns the solver's dial in its starting position
+func (s Solver) dial() (*Dial, error) {
+	if s.Size == 0 {
+		return NewDial(DefaultSize, DefaultStart)
+	}
+	return NewDial(s.Size, s.Start)
+}
@@ -0,0 +1,180 @@
+package day01
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{}, "../sample.txt", "3", "6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestVariant(t *testing.T) {
+	for _, tt := range []struct {
+		solver  Solver
+		variant bool
+	}{
+		{Solver{}, false},
+		{Solver{Size: DefaultSize, Start: DefaultStart}, false},
+		{Solver{Size: 10, Start: DefaultStart}, true},
+		{Solver{Size: DefaultSize, Start: 5}, true},
+	} {
+		if got := tt.solver.Variant(); got != tt.variant {
+			t.Errorf("%+v.Variant() = %t, want %t", tt.solver, got, tt.variant)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"L68\nR14\n", ""},
+		{"L68\n\nR14\n", ""},
+		{"L68\nX14\n", "2:1: expected L or R"},
+		{"L68\nR\n", "2:2: expected digit"},
+		{"L68\nR1x4\n", `2:3: unexpected "x4"`},
+		{"L68\nL-5\n", "2:2: expected digit"},
+		{"L68\nR9223372036854775808\n", "2:2: number 9223372036854775808 out of range"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if tt.err == "" && err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+		} else if tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestDialRotate(t *testing.T) {
+	tests := []struct {
+		size, start int
+		direction   byte
+		clicks      int
+		position    int
+		passes      int
+	}{
+		{100, 50, 'L', 68, 82, 1},
+		{100, 52, 'R', 48, 0, 1},
+		{100, 0, 'L', 5, 95, 0},
+		{100, 50, 'R', 1000, 50, 10},
+		{100, 50, 'L', 250, 0, 3},
+		{10, 3, 'R', 6, 9, 0},
+		{10, 3, 'R', 27, 0, 3},
+		{7, 0, 'L', 7, 0, 1},
+	}
+
+	for _, tt := range tests {
+		dial, err := NewDial(tt.size, tt.start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		passes := dial.Rotate(tt.direction, tt.clicks)
+		if dial.Position != tt.position || passes != tt.passes {
+			t.Errorf("dial %d at %d, %c%d: at %d passing 0 %d times, want at %d passing %d times",
+				tt.size, tt.start, tt.direction, tt.clicks, dial.Position, passes, tt.position, tt.passes)
+		}
+	}
+
+	if _, err := NewDial(10, 10); err == nil {
+		t.Error("NewDial(10, 10): want error for a start off the dial")
+	}
+}
+
+func TestLock(t *testing.T) {
+	lock, err := ParseLock("100:50,10,7:3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotations, err := Parse(strings.NewReader("L50\n2R13\n3R4\n2L3\n3L14\n1R100\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := lock.Run(rotations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LockRing{
+		{100, 50, Counts{Stops: 2, Passes: 2}},
+		{10, 0, Counts{Stops: 1, Passes: 2}},
+		{7, 3, Counts{Stops: 2, Passes: 3}},
+	}
+	if !slices.Equal(report.Rings, want) {
+		t.Errorf("rings = %v, want %v", report.Rings, want)
+	}
+	if report.Open != 4 {
+		t.Errorf("open after instruction %d, want 4", report.Open)
+	}
+
+	if _, err := lock.Run(Input{{'L', 1, 4}}); err == nil {
+		t.Error("turning ring 4 of 3: want error")
+	}
+	if _, err := ParseLock("100,0"); err == nil {
+		t.Error("ParseLock with a zero-sized ring: want error")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for trial := range 40 {
+		size := 2 + rng.IntN(30)
+		if trial%2 == 1 {
+			// too large a dial to tabulate, so Search simulates each edit
+			size += maxSuffixTable
+		}
+		start := rng.IntN(size)
+		rotations := make(Input, 1+rng.IntN(25))
+		for i := range rotations {
+			rotations[i] = Rotation{Direction: "LR"[rng.IntN(2)], Clicks: rng.IntN(3 * size), Ring: 1}
+		}
+
+		for _, goal := range []Goal{Open, MostPasses} {
+			edits, err := Search(size, start, rotations, goal, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, edit := range edits {
+				edited := slices.Clone(rotations)
+				if edit.Kind == Remove {
+					edited = slices.Delete(edited, edit.Index, edit.Index+1)
+				} else {
+					edited[edit.Index] = edit.Rotation
+				}
+				dial, _ := NewDial(size, start)
+				counts := Count(dial, edited, nil)
+				if dial.Position != edit.Final || counts.Passes != edit.Passes {
+					t.Fatalf("%+v on %v: resimulated final %d, passes %d", edit, rotations, dial.Position, counts.Passes)
+				}
+				if goal == Open && edit.Final != 0 {
+					t.Fatalf("%+v doesn't open the lock", edit)
+				}
+			}
+			if goal == MostPasses && len(edits) < 2*len(rotations) {
+				t.Fatalf("found %d edits, want every removal and flip of %d rotations", len(edits), len(rotations))
+			}
+		}
+	}
+}
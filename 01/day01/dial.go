@@ -0,0 +1,40 @@
+package day01
+
+import "fmt"
+
+// Dial is the safe's dial: a ring of Size positions numbered from 0, with the
+// pointer at Position
+type Dial struct {
+	Size     int
+	Position int
+}
+
+// NewDial returns a dial of size positions pointing at start
+func NewDial(size, start int) (*Dial, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("dial size %d must be positive", size)
+	}
+	if start < 0 || start >= size {
+		return nil, fmt.Errorf("start position %d is not on a dial of size %d", start, size)
+	}
+	return &Dial{Size: size, Position: start}, nil
+}
+
+// Rotate turns the dial clicks positions left (towards lower numbers) or
+// right, returning how many of those clicks point it at 0, including the
+// last one when it stops there
+func (d *Dial) Rotate(direction byte, clicks int) (passes int) {
+	unit := 1
+	if direction == 'L' {
+		unit = -1
+	}
+
+	// every full turn passes 0 once, then the remainder may reach it again
+	adjustedClicks := clicks % d.Size
+	passes = clicks / d.Size
+	if (unit == -1 && d.Position > 0 && d.Position <= adjustedClicks) || d.Position+unit*adjustedClicks >= d.Size {
+		passes++
+	}
+	d.Position = (d.Position + unit*adjustedClicks + d.Size) % d.Size
+	return passes
+}
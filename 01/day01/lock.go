@@ -0,0 +1,106 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Lock is a combination lock of several rings, each a dial of its own size
+// turned independently
+type Lock struct {
+	Rings []*Dial
+}
+
+// ParseLock reads a lock from a comma-separated list of rings, each a size
+// optionally followed by a colon and its start position, such as
+// "100:50,10,7:3". Rings without a start position start at 0.
+func ParseLock(spec string) (*Lock, error) {
+	var lock Lock
+	for _, ring := range strings.Split(spec, ",") {
+		sizeStr, startStr, hasStart := strings.Cut(ring, ":")
+		size, err := strconv.Atoi(sizeStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ring size %q", sizeStr)
+		}
+		start := 0
+		if hasStart {
+			if start, err = strconv.Atoi(startStr); err != nil {
+				return nil, fmt.Errorf("invalid ring start %q", startStr)
+			}
+		}
+		dial, err := NewDial(size, start)
+		if err != nil {
+			return nil, err
+		}
+		lock.Rings = append(lock.Rings, dial)
+	}
+	return &lock, nil
+}
+
+// LockReport is what turning a lock through a list of rotations did
+type LockReport struct {
+	Rings []LockRing
+	// Open is the number, from 1, of the first instruction after which every
+	// ring pointed at 0, or 0 if they never did all at once
+	Open int
+}
+
+// LockRing is one ring's share of a LockReport
+type LockRing struct {
+	Size, Start int
+	Counts
+}
+
+// Run turns the lock through every rotation, counting each ring's zero
+// crossings and watching for every ring to sit on 0 at once
+func (l *Lock) Run(rotations Input) (LockReport, error) {
+	report := LockReport{Rings: make([]LockRing, len(l.Rings))}
+	for i, ring := range l.Rings {
+		report.Rings[i] = LockRing{Size: ring.Size, Start: ring.Position}
+	}
+
+	onZero := 0
+	for _, ring := range l.Rings {
+		if ring.Position == 0 {
+			onZero++
+		}
+	}
+
+	for i, rotation := range rotations {
+		if rotation.Ring > len(l.Rings) {
+			return LockReport{}, fmt.Errorf("instruction %d turns ring %d, but the lock has %d", i+1, rotation.Ring, len(l.Rings))
+		}
+		ring := l.Rings[rotation.Ring-1]
+		counts := &report.Rings[rotation.Ring-1].Counts
+
+		if ring.Position == 0 {
+			onZero--
+		}
+		counts.Passes += ring.Rotate(rotation.Direction, rotation.Clicks)
+		if ring.Position == 0 {
+			counts.Stops++
+			onZero++
+		}
+
+		if onZero == len(l.Rings) && report.Open == 0 {
+			report.Open = i + 1
+		}
+	}
+	return report, nil
+}
+
+// Write prints a line per ring, then when the lock first opened.
+func (r LockReport) Write(w io.Writer, rotations Input) error {
+	for i, ring := range r.Rings {
+		fmt.Fprintf(w, "Ring %d (size %d, start %d): stops %d, passes %d\n", i+1, ring.Size, ring.Start, ring.Stops, ring.Passes)
+	}
+	if r.Open == 0 {
+		_, err := fmt.Fprintln(w, "Every ring on 0: never")
+		return err
+	}
+	rotation := rotations[r.Open-1]
+	_, err := fmt.Fprintf(w, "Every ring on 0: after instruction %d (%d%c%d)\n", r.Open, rotation.Ring, rotation.Direction, rotation.Clicks)
+	return err
+}
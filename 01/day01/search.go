@@ -0,0 +1,191 @@
+package day01
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Goal is what Search looks for in an edited list of rotations
+type Goal string
+
+const (
+	Open       Goal = "open"   // leave the dial on 0
+	MostPasses Goal = "passes" // point the dial at 0 as often as possible
+)
+
+// EditKind is how an Edit changes its instruction
+type EditKind string
+
+const (
+	Remove   EditKind = "remove"
+	Flip     EditKind = "flip"     // turn the same clicks the other way
+	Retarget EditKind = "retarget" // same direction, clicks changed to finish on 0
+)
+
+// Edit is a single change to one instruction, and where it leaves the dial
+type Edit struct {
+	Index    int // instruction changed, counting from 0
+	Kind     EditKind
+	Rotation Rotation // the replacement instruction, unless removed
+	Final    int      // position after every rotation
+	Passes   int      // clicks that pointed the dial at 0
+}
+
+// maxSuffixTable is the most entries Search keeps of the passes made by every
+// suffix of the rotations from every position, 32MiB of them
+const maxSuffixTable = 1 << 22
+
+// Search tries every single-instruction edit of rotations on a dial of size
+// positions starting at start, and returns the best for the goal, at most
+// limit of them (all of them when limit is 0). Open keeps the edits that
+// leave the dial on 0, ordered by passes; MostPasses orders every edit by
+// passes.
+//
+// Rather than simulating each edit, it keeps the position and passes after
+// every prefix of the list, and for every suffix the passes it would make
+// from each starting position, so each edit costs O(1) on top of
+// O(len(rotations) * size) set up. That table takes len(rotations) * size
+// ints, so when it would hold more than maxSuffixTable it simulates the rest
+// of the list for each edit instead, costing O(len(rotations)^2) time but
+// only O(len(rotations)) memory.
+func Search(size, start int, rotations Input, goal Goal, limit int) ([]Edit, error) {
+	if goal != Open && goal != MostPasses {
+		return nil, fmt.Errorf("unknown goal %q (want %s or %s)", goal, Open, MostPasses)
+	}
+	if _, err := NewDial(size, start); err != nil {
+		return nil, err
+	}
+	for i, rotation := range rotations {
+		if rotation.Ring != 1 {
+			return nil, fmt.Errorf("instruction %d turns ring %d, but the search works on one ring", i+1, rotation.Ring)
+		}
+	}
+
+	n := len(rotations)
+	// prefixPosition[i] and prefixPasses[i] are the dial before rotation i
+	prefixPosition := make([]int, n+1)
+	prefixPasses := make([]int, n+1)
+	prefixPosition[0] = start
+	for i, rotation := range rotations {
+		dial := Dial{size, prefixPosition[i]}
+		prefixPasses[i+1] = prefixPasses[i] + dial.Rotate(rotation.Direction, rotation.Clicks)
+		prefixPosition[i+1] = dial.Position
+	}
+
+	// suffixDelta[i] is how far rotations i onwards move the dial
+	suffixDelta := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		suffixDelta[i] = (suffixDelta[i+1] + rotations[i].delta(size)) % size
+	}
+
+	// suffixPasses returns the passes made by rotations i onwards starting
+	// from position p
+	suffixPasses := func(i, p int) int {
+		passes := 0
+		dial := Dial{size, p}
+		for _, rotation := range rotations[i:] {
+			passes += dial.Rotate(rotation.Direction, rotation.Clicks)
+		}
+		return passes
+	}
+	if size <= maxSuffixTable/(n+1) {
+		// table[i*size+p] is suffixPasses(i, p)
+		table := make([]int, (n+1)*size)
+		for i := n - 1; i >= 0; i-- {
+			for p := range size {
+				dial := Dial{size, p}
+				passes := dial.Rotate(rotations[i].Direction, rotations[i].Clicks)
+				table[i*size+p] = passes + table[(i+1)*size+dial.Position]
+			}
+		}
+		suffixPasses = func(i, p int) int { return table[i*size+p] }
+	}
+
+	// apply evaluates replacing instruction i with rotation, or removing it
+	var edits []Edit
+	apply := func(i int, kind EditKind, rotation Rotation) {
+		dial := Dial{size, prefixPosition[i]}
+		passes := prefixPasses[i]
+		if kind != Remove {
+			passes += dial.Rotate(rotation.Direction, rotation.Clicks)
+		}
+		passes += suffixPasses(i+1, dial.Position)
+		final := (dial.Position + suffixDelta[i+1]) % size
+		if goal == Open && final != 0 {
+			return
+		}
+		edits = append(edits, Edit{Index: i, Kind: kind, Rotation: rotation, Final: final, Passes: passes})
+	}
+
+	final := prefixPosition[n]
+	for i, rotation := range rotations {
+		apply(i, Remove, Rotation{})
+		flipped := rotation
+		flipped.Direction = opposite(rotation.Direction)
+		apply(i, Flip, flipped)
+
+		if final == 0 {
+			continue
+		}
+		// the clicks that finish on 0 differ from the current ones by final
+		// one way or size-final the other; try the nearest either side
+		shift := final
+		if rotation.Direction == 'L' {
+			shift = size - final
+		}
+		for _, clicks := range []int{rotation.Clicks - shift, rotation.Clicks + size - shift} {
+			if clicks >= 0 {
+				retargeted := rotation
+				retargeted.Clicks = clicks
+				apply(i, Retarget, retargeted)
+			}
+		}
+	}
+
+	slices.SortStableFunc(edits, func(a, b Edit) int {
+		return cmp.Compare(b.Passes, a.Passes)
+	})
+	if limit > 0 && len(edits) > limit {
+		edits = edits[:limit]
+	}
+	return edits, nil
+}
+
+// WriteEdits prints the outcome of the unedited rotations, then a line per edit
+func WriteEdits(w io.Writer, dial *Dial, rotations Input, edits []Edit) error {
+	counts := Count(dial, rotations, nil)
+	fmt.Fprintf(w, "Unedited: final %d, passes %d\n", dial.Position, counts.Passes)
+	if len(edits) == 0 {
+		_, err := fmt.Fprintln(w, "No single edit found")
+		return err
+	}
+	for _, edit := range edits {
+		original := rotations[edit.Index]
+		change := string(edit.Kind)
+		if edit.Kind != Remove {
+			change = fmt.Sprintf("%s to %c%d", edit.Kind, edit.Rotation.Direction, edit.Rotation.Clicks)
+		}
+		if _, err := fmt.Fprintf(w, "%d: %c%d %s: final %d, passes %d\n",
+			edit.Index+1, original.Direction, original.Clicks, change, edit.Final, edit.Passes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delta is how far the rotation moves a dial of size positions, from 0 to size-1
+func (r Rotation) delta(size int) int {
+	if r.Direction == 'L' {
+		return (size - r.Clicks%size) % size
+	}
+	return r.Clicks % size
+}
+
+func opposite(direction byte) byte {
+	if direction == 'L' {
+		return 'R'
+	}
+	return 'L'
+}
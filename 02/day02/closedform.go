@@ -0,0 +1,93 @@
+package day02
+
+import "math/big"
+
+// maxUint64Digits is the number of digits in the largest uint64
+const maxUint64Digits = 20
+
+// Closed form approach: sum the invalid IDs of each range without visiting
+// them. The numbers with D digits made of an L-digit pattern repeated k = D/L
+// times are exactly the multiples P * M of the multiplier
+// M = (10^D - 1) / (10^L - 1), such as 12 * 10101 = 121212, for P from
+// 10^(L-1) to 10^L - 1. Those inside a range form an arithmetic series.
+//
+// A series for k also takes in the numbers whose shortest pattern repeats a
+// multiple of k times (11 * 101 = 1111 is 1 repeated 4 times), so the sum for
+// each exact repeat count is found by inclusion-exclusion over the divisors of
+// D, largest first, before asking the policy which to keep.
+func solveWithClosedForm(ranges []Range, policy Policy) *big.Int {
+	total := new(big.Int)
+	for _, r := range ranges {
+		if r.End < 1 || r.Start > r.End {
+			continue
+		}
+		total.Add(total, sumInRange(max(r.Start, 1), r.End, policy))
+	}
+	return total
+}
+
+// sumInRange sums the IDs from lo to hi the policy counts as invalid, where
+// 1 <= lo <= hi
+func sumInRange(lo, hi uint64, policy Policy) *big.Int {
+	policy = policy.orDefault()
+	total := new(big.Int)
+	for digits := max(2, countDigits(lo)); digits <= countDigits(hi); digits++ {
+		from, to := max(lo, pow10(digits-1)), hi
+		if digits < maxUint64Digits {
+			to = min(hi, pow10(digits)-1)
+		}
+
+		// exact[k] is the sum of the numbers whose shortest pattern repeats
+		// exactly k times
+		exact := make([]*big.Int, digits+1)
+		for k := digits; k >= 2; k-- {
+			if digits%k != 0 {
+				continue
+			}
+			sum := seriesSum(from, to, digits, digits/k)
+			for multiple := 2 * k; multiple <= digits; multiple += k {
+				if exact[multiple] != nil {
+					sum.Sub(sum, exact[multiple])
+				}
+			}
+			exact[k] = sum
+			if policy.repeats(k) > 0 {
+				total.Add(total, sum)
+			}
+		}
+	}
+	return total
+}
+
+// seriesSum sums the digits-digit numbers from lo to hi made of a patternLen
+// digit pattern repeated
+func seriesSum(lo, hi uint64, digits, patternLen int) *big.Int {
+	ten := big.NewInt(10)
+	one := big.NewInt(1)
+	pow := func(n int) *big.Int { return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil) }
+
+	multiplier := new(big.Int).Sub(pow(digits), one)
+	multiplier.Quo(multiplier, new(big.Int).Sub(pow(patternLen), one))
+
+	// the patterns P with lo <= P * multiplier <= hi
+	first := new(big.Int).SetUint64(lo)
+	first.Add(first, multiplier).Sub(first, one).Quo(first, multiplier)
+	if smallest := pow(patternLen - 1); first.Cmp(smallest) < 0 {
+		first = smallest
+	}
+	last := new(big.Int).SetUint64(hi)
+	last.Quo(last, multiplier)
+	if largest := new(big.Int).Sub(pow(patternLen), one); last.Cmp(largest) > 0 {
+		last = largest
+	}
+	if first.Cmp(last) > 0 {
+		return new(big.Int)
+	}
+
+	// multiplier * (first + last) * count / 2
+	count := new(big.Int).Sub(last, first)
+	count.Add(count, one)
+	sum := new(big.Int).Add(first, last)
+	sum.Mul(sum, count).Rsh(sum, 1)
+	return sum.Mul(sum, multiplier)
+}
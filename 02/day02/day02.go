@@ -0,0 +1,350 @@
+package day02
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"math/bits"
+	"strconv"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+type Solver struct {
+	// PatternGeneration selects the pattern generation algorithm instead of brute force
+	PatternGeneration bool
+	// ClosedForm sums each range with arithmetic series instead, taking
+	// precedence over PatternGeneration
+	ClosedForm bool
+	// Parallel splits the brute force scan across Workers goroutines
+	// (runtime.NumCPU() if 0), ChunkSize IDs at a time (DefaultChunkSize if 0)
+	Parallel  bool
+	Workers   int
+	ChunkSize uint64
+	// Policy decides which IDs part 2 counts as invalid, TwiceOrMore if unset
+	Policy Policy
+}
+
+func (s Solver) Part1(input []byte) (any, error) {
+	return s.solve(input, ExactlyTwice)
+}
+
+func (s Solver) Part2(input []byte) (any, error) {
+	return s.solve(input, s.Policy)
+}
+
+func (s Solver) solve(input []byte, policy Policy) (any, error) {
+	ranges, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	if s.ClosedForm {
+		return solveWithClosedForm(ranges, policy), nil
+	}
+	var sum Sum
+	switch {
+	case s.PatternGeneration:
+		sum, err = solveWithPatternGeneration(ranges, policy)
+	case s.Parallel:
+		sum, err = solveWithParallelBruteForce(ranges, policy, s.Workers, s.ChunkSize)
+	default:
+		sum, err = solveWithBruteForce(ranges, policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sum.Big(), nil
+}
+
+// Algorithm describes the algorithm the solver is configured to use
+// Variant reports whether part 2 counts IDs by a policy other than the
+// puzzle's, TwiceOrMore
+func (s Solver) Variant() bool {
+	return s.Policy.String() != TwiceOrMore.String()
+}
+
+func (s Solver) Algorithm() string {
+	if s.ClosedForm {
+		return "Closed Form"
+	}
+	if s.PatternGeneration {
+		return "Pattern Generation"
+	}
+	if s.Parallel {
+		return "Brute Force (Parallel)"
+	}
+	return "Brute Force (Optimized)"
+}
+
+// Input is the list of ID ranges to search
+type Input []Range
+
+// Parse reads the comma-separated ranges, such as 11-22,95-115, which may be
+// spread over several lines. IDs may be as large as a uint64.
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges Input
+	for _, line := range lines {
+		for !line.Done() {
+			start, err := line.Uint64()
+			if err != nil {
+				return nil, err
+			}
+			if err := line.Expect("-"); err != nil {
+				return nil, err
+			}
+			end, err := line.Uint64()
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, Range{start, end})
+			if !line.Accept(',') {
+				if err := line.End(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return ranges, nil
+}
+
+// Brute force approach: check every number in each range
+func solveWithBruteForce(ranges []Range, policy Policy) (Sum, error) {
+	return sumMatches(policy.Scan(ranges))
+}
+
+// sumMatches totals the IDs of the matches
+func sumMatches(matches iter.Seq[Match]) (Sum, error) {
+	var total Sum
+	for match := range matches {
+		if err := total.Add(match.ID); err != nil {
+			return Sum{}, err
+		}
+	}
+	return total, nil
+}
+
+// Match is an invalid ID found in a range: Pattern repeated Repeats times
+type Match struct {
+	ID      uint64
+	Range   Range
+	Pattern uint64 // the pattern the policy counts, e.g. 12 for 121212
+	Repeats int
+}
+
+// ScanRanges checks every ID of every range in order, yielding each one
+// TwiceOrMore counts as invalid
+func ScanRanges(ranges []Range) iter.Seq[Match] {
+	return TwiceOrMore.Scan(ranges)
+}
+
+// Scan checks every ID of every range in order, yielding each invalid one.
+// An ID in several overlapping ranges is yielded once for each.
+func (p Policy) Scan(ranges []Range) iter.Seq[Match] {
+	p = p.orDefault()
+	return func(yield func(Match) bool) {
+		buf := make([]byte, 0, 20)
+		for _, r := range ranges {
+			for id := r.Start; id <= r.End; id++ {
+				if match, ok := p.match(id, r, buf); ok && !yield(match) {
+					return
+				}
+				if id == r.End {
+					break // r.End may be the largest uint64, which id++ would wrap
+				}
+			}
+		}
+	}
+}
+
+// match checks whether the policy counts id as invalid
+func (p Policy) match(id uint64, r Range, buf []byte) (Match, bool) {
+	patternLen := repeatingPatternLength(id, buf)
+	if patternLen == 0 {
+		return Match{}, false
+	}
+	digits := countDigits(id)
+	repeats := p.repeats(digits / patternLen)
+	if repeats == 0 {
+		return Match{}, false
+	}
+	return Match{
+		ID:      id,
+		Range:   r,
+		Pattern: id / pow10(digits-digits/repeats),
+		Repeats: repeats,
+	}, true
+}
+
+// Pattern generation approach: generate only valid pattern numbers
+func solveWithPatternGeneration(ranges []Range, policy Policy) (Sum, error) {
+	return sumMatches(policy.Generate(ranges))
+}
+
+// Generate builds every number the policy counts as invalid within the
+// ranges, yielding it once for each range it falls in, like Scan but not in
+// order. Each number is built only from the repeat count the policy reports
+// for it, so none is yielded twice for the same range.
+//
+// A digits-digit number made of a patternLen-digit pattern repeated is the
+// pattern times a multiplier such as 10101, so only the patterns from
+// ceil(start/multiplier) to floor(end/multiplier) of each range are tried,
+// as seriesSum counts them.
+func (p Policy) Generate(ranges []Range) iter.Seq[Match] {
+	p = p.orDefault()
+	return func(yield func(Match) bool) {
+		maxNum := uint64(0)
+		for _, r := range ranges {
+			maxNum = max(maxNum, r.End)
+		}
+		maxDigits := countDigits(maxNum)
+		buf := make([]byte, 0, 20)
+
+		for digits := 2; digits <= maxDigits; digits++ {
+			smallest, largest := pow10(digits-1), uint64(math.MaxUint64)
+			if digits < maxUint64Digits {
+				largest = pow10(digits) - 1
+			}
+			for repeats := 2; repeats <= digits; repeats++ {
+				if digits%repeats != 0 || !p.allows(repeats) {
+					continue
+				}
+				patternLen := digits / repeats
+				// multiplier < 10^(digits-patternLen+1), so it fits in a uint64
+				multiplier := uint64(0)
+				for range repeats {
+					multiplier = multiplier*pow10(patternLen) + 1
+				}
+
+				for _, r := range ranges {
+					lo, hi := max(r.Start, smallest), min(r.End, largest)
+					if lo > hi {
+						continue
+					}
+					first := max(lo/multiplier, pow10(patternLen-1))
+					if first*multiplier < lo {
+						first++
+					}
+					last := min(hi/multiplier, pow10(patternLen)-1)
+					for pattern := first; pattern <= last; pattern++ {
+						// 1111 is 11 twice but 1 four times: skip it unless
+						// the policy reports it as repeats
+						primitive := repeats
+						if n := repeatingPatternLength(pattern, buf); n > 0 {
+							primitive *= patternLen / n
+						}
+						if p.repeats(primitive) != repeats {
+							continue
+						}
+						num, _ := generateRepeatedNumber(pattern, repeats) // at most hi, so it fits
+						if !yield(Match{ID: num, Range: r, Pattern: pattern, Repeats: repeats}) {
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// hasRepeatingPattern reports whether the policy counts n as invalid
+func hasRepeatingPattern(n uint64, buf []byte, policy Policy) bool {
+	patternLen := repeatingPatternLength(n, buf)
+	return patternLen > 0 && policy.orDefault().repeats(countDigits(n)/patternLen) > 0
+}
+
+// repeatingPatternLength returns the length of the shortest pattern that n
+// repeats at least twice, or 0 if there is none
+func repeatingPatternLength(n uint64, buf []byte) int {
+	// Convert to string in-place
+	s := strconv.AppendUint(buf[:0], n, 10)
+	sLen := len(s)
+
+	// Try all possible pattern lengths from 1 to half the string length
+	for patternLen := 1; patternLen <= sLen/2; patternLen++ {
+		if sLen%patternLen != 0 {
+			continue
+		}
+
+		repeats := sLen / patternLen
+		matched := true
+
+		// Compare bytes directly without creating substrings
+		for j := 1; j < repeats; j++ {
+			offset := j * patternLen
+			for k := 0; k < patternLen; k++ {
+				if s[k] != s[offset+k] {
+					matched = false
+					break
+				}
+			}
+			if !matched {
+				break
+			}
+		}
+
+		if matched {
+			return patternLen
+		}
+	}
+
+	return 0
+}
+
+// Range is an inclusive range of IDs
+type Range struct {
+	Start uint64
+	End   uint64
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// Generate a number by repeating a pattern, reporting false if it doesn't
+// fit in a uint64
+func generateRepeatedNumber(pattern uint64, repeats int) (uint64, bool) {
+	shift := pow10(countDigits(pattern))
+
+	// Build the number from left to right, a pattern at a time
+	result := uint64(0)
+	for range repeats {
+		hi, lo := bits.Mul64(result, shift)
+		if hi != 0 {
+			return 0, false
+		}
+		var carry uint64
+		result, carry = bits.Add64(lo, pattern, 0)
+		if carry != 0 {
+			return 0, false
+		}
+	}
+
+	return result, true
+}
+
+// pow10 returns 10^n, for n up to 19
+func pow10(n int) uint64 {
+	result := uint64(1)
+	for range n {
+		result *= 10
+	}
+	return result
+}
+
+// Count digits in a number
+func countDigits(n uint64) int {
+	count := 1
+	for n >= 10 {
+		count++
+		n /= 10
+	}
+	return count
+}
@@ -0,0 +1,289 @@
+package day02
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample brute force", Solver{}, "../sample.txt", "1227775554", "4174379265"},
+		{"sample pattern generation", Solver{PatternGeneration: true}, "../sample.txt", "1227775554", "4174379265"},
+		{"sample parallel", Solver{Parallel: true, Workers: 3, ChunkSize: 4}, "../sample.txt", "1227775554", "4174379265"},
+		{"sample closed form", Solver{ClosedForm: true}, "../sample.txt", "1227775554", "4174379265"},
+		{"sample closed form prime", Solver{ClosedForm: true, Policy: PrimeRepeatsOnly}, "../sample.txt", "1227775554", "4174157043"},
+		{"sample exactly 3", Solver{Policy: ExactlyN(3)}, "../sample.txt", "1227775554", "825613812"},
+		{"sample pattern generation prime", Solver{PatternGeneration: true, Policy: PrimeRepeatsOnly}, "../sample.txt", "1227775554", "4174157043"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestHasRepeatingPattern(t *testing.T) {
+	tests := []struct {
+		n      uint64
+		policy Policy
+		want   bool
+	}{
+		{1, Policy{}, false},
+		{11, Policy{}, true},
+		{12, Policy{}, false},
+		{101, Policy{}, false},
+		{111, Policy{}, true},
+		{1010, Policy{}, true},
+		{1212, Policy{}, true},
+		{1221, Policy{}, false},
+		{123123, Policy{}, true},
+		{123123123, Policy{}, true},
+		{1231231234, Policy{}, false},
+		{1188511885, Policy{}, true},
+		{1698522, Policy{}, false},
+		{1212, ExactlyTwice, true},
+		{1111, ExactlyTwice, true},
+		{111, ExactlyTwice, false},
+		{123123123, ExactlyN(3), true},
+		{11111111, ExactlyN(4), true},
+		{121212, ExactlyN(4), false},
+		{121212, AtLeastN(3), true},
+		{1212, AtLeastN(3), false},
+		{121212, PrimeRepeatsOnly, true},
+		{12121212, PrimeRepeatsOnly, false},
+		{11111, PrimeRepeatsOnly, true},
+	}
+
+	buf := make([]byte, 0, 20)
+	for _, tt := range tests {
+		if got := hasRepeatingPattern(tt.n, buf, tt.policy); got != tt.want {
+			t.Errorf("hasRepeatingPattern(%d, %s) = %t, want %t", tt.n, tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateRepeatedNumber(t *testing.T) {
+	tests := []struct {
+		pattern uint64
+		repeats int
+		want    uint64
+		ok      bool
+	}{
+		{7, 0, 0, true},
+		{7, 1, 7, true},
+		{7, 3, 777, true},
+		{12, 2, 1212, true},
+		{123, 3, 123123123, true},
+		{10, 2, 1010, true},
+		{1844674407, 2, 18446744071844674407, true},
+		{1844674408, 2, 0, false},
+		{1, 20, 11111111111111111111, true},
+		{2, 20, 0, false},
+	}
+
+	for _, tt := range tests {
+		if got, ok := generateRepeatedNumber(tt.pattern, tt.repeats); got != tt.want || ok != tt.ok {
+			t.Errorf("generateRepeatedNumber(%d, %d) = %d, %t, want %d, %t", tt.pattern, tt.repeats, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestScanRanges(t *testing.T) {
+	ranges := []Range{{95, 115}, {1110, 1112}, {824824821, 824824827}}
+	want := []Match{
+		{99, ranges[0], 9, 2},
+		{111, ranges[0], 1, 3},
+		{1111, ranges[1], 1, 4},
+		{824824824, ranges[2], 824, 3},
+	}
+	if got := slices.Collect(ScanRanges(ranges)); !slices.Equal(got, want) {
+		t.Errorf("ScanRanges = %v, want %v", got, want)
+	}
+
+	totals, err := PerRange(ranges, Policy{})
+	if err != nil || totals[0].Count != 2 || totals[0].Sum.String() != "210" || totals[1].Sum.String() != "1111" {
+		t.Errorf("PerRange = %v", totals)
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name, want string
+		variant    bool
+		wantErr    bool
+	}{
+		{"twice", "exactly-2", true, false},
+		{"prime", "prime", true, false},
+		{"exactly-3", "exactly-3", true, false},
+		{"at-least-2", "at-least-2", false, false},
+		{"at-least-1", "", false, true},
+		{"exactly-x", "", false, true},
+		{"thrice", "", false, true},
+	}
+
+	for _, tt := range tests {
+		policy, err := ParsePolicy(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePolicy(%q) error = %v, want error %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && policy.String() != tt.want {
+			t.Errorf("ParsePolicy(%q) = %s, want %s", tt.name, policy, tt.want)
+		}
+		if err == nil && (Solver{Policy: policy}).Variant() != tt.variant {
+			t.Errorf("Solver with policy %s: Variant() = %t, want %t", policy, !tt.variant, tt.variant)
+		}
+	}
+	if (Solver{}).Variant() {
+		t.Error("the zero Solver is a variant")
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	// overlapping ranges, and ranges spanning several digit counts
+	ranges := []Range{{1, 120}, {100, 1300}, {1000, 1111}, {99990, 1001100}, {11111100, 11111200}}
+	policies := []Policy{{}, ExactlyTwice, ExactlyN(3), ExactlyN(4), AtLeastN(3), PrimeRepeatsOnly}
+	for _, policy := range policies {
+		if err := CrossCheck(ranges, policy); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSumInRange(t *testing.T) {
+	tests := []struct {
+		lo, hi uint64
+		policy Policy
+		want   string
+	}{
+		{1, 99, Policy{}, "495"},
+		{1, 99, PrimeRepeatsOnly, "495"},
+		{1000, 1111, ExactlyTwice, "2121"},
+		{1000, 1111, AtLeastN(3), "1111"},
+		{1000, 1111, AtLeastN(5), "0"},
+		// only 1844674407 twice fits between here and the largest uint64
+		{18446744071844674407, math.MaxUint64, Policy{}, "18446744071844674407"},
+		{18446744071844674408, math.MaxUint64, Policy{}, "0"},
+		{11111111111111111111, 11111111111111111111, ExactlyN(20), "11111111111111111111"},
+		{11111111111111111111, 11111111111111111111, ExactlyN(4), "11111111111111111111"},
+		{11111111111111111111, 11111111111111111111, ExactlyN(3), "0"},
+	}
+
+	for _, tt := range tests {
+		if got := sumInRange(tt.lo, tt.hi, tt.policy); got.String() != tt.want {
+			t.Errorf("sumInRange(%d, %d, %s) = %s, want %s", tt.lo, tt.hi, tt.policy, got, tt.want)
+		}
+	}
+
+	// the whole uint64 range, which no enumeration could reach
+	if got := sumInRange(1, math.MaxUint64, Policy{}); got.Cmp(new(big.Int).SetUint64(math.MaxUint64)) <= 0 {
+		t.Errorf("sumInRange over every uint64 = %s, want more than the largest uint64", got)
+	}
+}
+
+func TestLargeIDs(t *testing.T) {
+	// IDs past the largest int64, ending on the largest uint64
+	input := []byte("18446744071844674400-18446744071844674500,18446744073709551600-18446744073709551615,9999999999999999990-10000000000000000010\n")
+	for _, solver := range []Solver{{}, {PatternGeneration: true}, {Parallel: true, ChunkSize: 7}, {ClosedForm: true}} {
+		aoctest.CheckPart(t, solver.Algorithm(), solver.Part2, input, "28446744071844674406")
+	}
+	ranges, err := Parse(strings.NewReader(string(input)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, policy := range []Policy{ExactlyTwice, PrimeRepeatsOnly, AtLeastN(4)} {
+		if err := CrossCheck(ranges, policy); err != nil {
+			t.Errorf("CrossCheck(%s) on IDs past the largest int64: %v", policy, err)
+		}
+	}
+
+	_, err = Parse(strings.NewReader("1-18446744073709551616"))
+	if err == nil || err.Error() != "1:3: number 18446744073709551616 out of range" {
+		t.Errorf("Parse past the largest uint64: error = %v", err)
+	}
+}
+
+func TestSplitRanges(t *testing.T) {
+	ranges := []Range{{1, 10}, {20, 22}, {30, 29}, {math.MaxUint64 - 4, math.MaxUint64}}
+	want := []Range{{1, 4}, {5, 8}, {9, 10}, {20, 22}, {math.MaxUint64 - 4, math.MaxUint64 - 1}, {math.MaxUint64, math.MaxUint64}}
+	if got := splitRanges(ranges, 4); !slices.Equal(got, want) {
+		t.Errorf("splitRanges = %v, want %v", got, want)
+	}
+}
+
+func TestSum(t *testing.T) {
+	var sum Sum
+	for range 3 {
+		if err := sum.Add(math.MaxUint64); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := sum.String(), "55340232221128654845"; got != want {
+		t.Errorf("3 * MaxUint64 = %s, want %s", got, want)
+	}
+
+	full := Sum{math.MaxUint64, math.MaxUint64}
+	if err := full.Add(1); err != ErrOverflow {
+		t.Errorf("Add past 128 bits: error = %v, want ErrOverflow", err)
+	}
+	if err := sum.AddSum(full); err != ErrOverflow {
+		t.Errorf("AddSum past 128 bits: error = %v, want ErrOverflow", err)
+	}
+}
+
+func BenchmarkSolver(b *testing.B) {
+	input := aoctest.ReadBenchInput(b, "..")
+	variants := []struct {
+		name   string
+		solver Solver
+	}{
+		{"BruteForce", Solver{}},
+		{"ParallelBruteForce", Solver{Parallel: true}},
+		{"PatternGeneration", Solver{PatternGeneration: true}},
+		{"ClosedForm", Solver{ClosedForm: true}},
+	}
+
+	for _, v := range variants {
+		b.Run(v.name, func(b *testing.B) {
+			for b.Loop() {
+				v.solver.Part2(input)
+			}
+		})
+	}
+}
+
+// BenchmarkBruteForce compares the single-threaded and parallel scans over a
+// few million IDs, more than the sample has
+func BenchmarkBruteForce(b *testing.B) {
+	input := []byte("1-2000000,123000000-124000000,9876500000-9877500000\n")
+	variants := []struct {
+		name   string
+		solver Solver
+	}{
+		{"SingleThreaded", Solver{}},
+		{"Parallel", Solver{Parallel: true}},
+		{"Parallel1Worker", Solver{Parallel: true, Workers: 1}},
+		{"ParallelSmallChunks", Solver{Parallel: true, ChunkSize: 1024}},
+	}
+
+	for _, v := range variants {
+		b.Run(v.name, func(b *testing.B) {
+			for b.Loop() {
+				v.solver.Part2(input)
+			}
+		})
+	}
+}
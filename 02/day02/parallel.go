@@ -0,0 +1,98 @@
+package day02
+
+import (
+	"runtime"
+	"sync"
+)
+
+// DefaultChunkSize is how many IDs a parallel worker checks at a time when
+// the solver doesn't set ChunkSize
+const DefaultChunkSize = 1 << 16
+
+// Parallel brute force approach: split the ranges into chunks and check them
+// on a pool of workers, each with its own buffer. Every chunk is totalled on
+// its own and the totals are added up in chunk order, so the answer and any
+// overflow error don't depend on how the chunks were scheduled.
+func solveWithParallelBruteForce(ranges []Range, policy Policy, workers int, chunkSize uint64) (Sum, error) {
+	policy = policy.orDefault()
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	chunks := splitRanges(ranges, chunkSize)
+	totals := make([]Sum, len(chunks))
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	chunkChan := make(chan int, len(chunks))
+
+	// Start workers
+	for range min(workers, len(chunks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, 0, 20)
+			for idx := range chunkChan {
+				totals[idx], errs[idx] = sumChunk(chunks[idx], policy, buf)
+			}
+		}()
+	}
+
+	// Send work
+	for i := range chunks {
+		chunkChan <- i
+	}
+	close(chunkChan)
+	wg.Wait()
+
+	var total Sum
+	for i := range chunks {
+		if errs[i] != nil {
+			return Sum{}, errs[i]
+		}
+		if err := total.AddSum(totals[i]); err != nil {
+			return Sum{}, err
+		}
+	}
+	return total, nil
+}
+
+// sumChunk totals the IDs in r the policy counts as invalid
+func sumChunk(r Range, policy Policy, buf []byte) (Sum, error) {
+	var total Sum
+	for id := r.Start; id <= r.End; id++ {
+		if match, ok := policy.match(id, r, buf); ok {
+			if err := total.Add(match.ID); err != nil {
+				return Sum{}, err
+			}
+		}
+		if id == r.End {
+			break // r.End may be the largest uint64, which id++ would wrap
+		}
+	}
+	return total, nil
+}
+
+// splitRanges cuts each range into consecutive chunks of at most size IDs,
+// keeping their order
+func splitRanges(ranges []Range, size uint64) []Range {
+	var chunks []Range
+	for _, r := range ranges {
+		if r.Start > r.End {
+			continue
+		}
+		for start := r.Start; ; start += size {
+			end := r.End
+			if r.End-start >= size {
+				end = start + size - 1
+			}
+			chunks = append(chunks, Range{start, end})
+			if end == r.End {
+				break
+			}
+		}
+	}
+	return chunks
+}
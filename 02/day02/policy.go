@@ -0,0 +1,165 @@
+package day02
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Policy decides which IDs are invalid, from how many times the shortest
+// pattern in the ID repeats. 121212 is 12 repeated 3 times; 11111111 is 1
+// repeated 8 times, but also 11 repeated 4 times and 1111 repeated twice.
+type Policy struct {
+	name string
+	// repeats returns the repeat count an ID whose shortest pattern repeats
+	// primitive times is invalid under, or 0 if it is valid
+	repeats func(primitive int) int
+	// allows reports whether repeats can be returned for some ID, so
+	// generation can skip the rest
+	allows func(repeats int) bool
+}
+
+var (
+	// ExactlyTwice flags IDs made of one pattern repeated twice, the part 1 rule
+	ExactlyTwice = ExactlyN(2)
+	// TwiceOrMore flags IDs made of one pattern repeated two or more times,
+	// the part 2 rule
+	TwiceOrMore = AtLeastN(2)
+	// PrimeRepeatsOnly flags IDs whose shortest pattern repeats a prime
+	// number of times, so 121212 is invalid but 12121212 isn't
+	PrimeRepeatsOnly = Policy{
+		name: "prime",
+		repeats: func(primitive int) int {
+			if isPrime(primitive) {
+				return primitive
+			}
+			return 0
+		},
+		allows: isPrime,
+	}
+)
+
+// ExactlyN flags IDs made of one pattern repeated exactly k times. The
+// pattern itself may repeat, so 1111 is 11 repeated twice.
+func ExactlyN(k int) Policy {
+	return Policy{
+		name: fmt.Sprintf("exactly-%d", k),
+		repeats: func(primitive int) int {
+			if primitive%k == 0 {
+				return k
+			}
+			return 0
+		},
+		allows: func(repeats int) bool { return repeats == k },
+	}
+}
+
+// AtLeastN flags IDs made of one pattern repeated k or more times.
+func AtLeastN(k int) Policy {
+	return Policy{
+		name: fmt.Sprintf("at-least-%d", k),
+		repeats: func(primitive int) int {
+			if primitive >= k {
+				return primitive
+			}
+			return 0
+		},
+		allows: func(repeats int) bool { return repeats >= k },
+	}
+}
+
+// ParsePolicy reads a policy by name: twice, prime, exactly-N or at-least-N.
+func ParsePolicy(name string) (Policy, error) {
+	switch name {
+	case "twice":
+		return ExactlyTwice, nil
+	case "prime":
+		return PrimeRepeatsOnly, nil
+	}
+	policy := ExactlyN
+	k, ok := strings.CutPrefix(name, "exactly-")
+	if !ok {
+		policy = AtLeastN
+		if k, ok = strings.CutPrefix(name, "at-least-"); !ok {
+			return Policy{}, fmt.Errorf("unknown policy %q (want twice, prime, exactly-N or at-least-N)", name)
+		}
+	}
+	n, err := strconv.Atoi(k)
+	if err != nil || n < 2 {
+		return Policy{}, fmt.Errorf("invalid repeat count in policy %q (want 2 or more)", name)
+	}
+	return policy(n), nil
+}
+
+func (p Policy) String() string {
+	if p.name == "" {
+		return TwiceOrMore.name
+	}
+	return p.name
+}
+
+// Set implements flag.Value.
+func (p *Policy) Set(name string) error {
+	policy, err := ParsePolicy(name)
+	if err != nil {
+		return err
+	}
+	*p = policy
+	return nil
+}
+
+// orDefault returns the policy, or TwiceOrMore for the zero Policy
+func (p Policy) orDefault() Policy {
+	if p.repeats == nil {
+		return TwiceOrMore
+	}
+	return p
+}
+
+// CrossCheck runs both the brute force scan and pattern generation with the
+// policy, and returns an error listing the matches only one of them found, or
+// if the closed form sum differs from theirs
+func CrossCheck(ranges []Range, policy Policy) error {
+	found := make(map[Match]int)
+	var sum Sum
+	for match := range policy.Scan(ranges) {
+		found[match]++
+		if err := sum.Add(match.ID); err != nil {
+			return err
+		}
+	}
+	for match := range policy.Generate(ranges) {
+		found[match]--
+	}
+
+	var diffs []string
+	for match, n := range found {
+		switch {
+		case n > 0:
+			diffs = append(diffs, fmt.Sprintf("%d in %s: %d x %d only found by brute force", match.ID, match.Range, match.Pattern, match.Repeats))
+		case n < 0:
+			diffs = append(diffs, fmt.Sprintf("%d in %s: %d x %d only found by pattern generation", match.ID, match.Range, match.Pattern, match.Repeats))
+		}
+	}
+	if len(diffs) > 0 {
+		slices.Sort(diffs)
+		return fmt.Errorf("policy %s: brute force and pattern generation disagree on %d IDs:\n%s", policy, len(diffs), strings.Join(diffs, "\n"))
+	}
+	if closed := solveWithClosedForm(ranges, policy); closed.Cmp(sum.Big()) != 0 {
+		return fmt.Errorf("policy %s: closed form sums to %s, brute force to %s", policy, closed, sum)
+	}
+	return nil
+}
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"iter"
+)
+
+// RangeTotal is what scanning one range found
+type RangeTotal struct {
+	Range Range
+	Count int // invalid IDs in the range
+	Sum   Sum // their total
+}
+
+// PerRange scans each range, totalling the IDs the policy counts as invalid
+func PerRange(ranges []Range, policy Policy) ([]RangeTotal, error) {
+	totals := make([]RangeTotal, len(ranges))
+	for i, r := range ranges {
+		totals[i].Range = r
+		for match := range policy.Scan([]Range{r}) {
+			totals[i].Count++
+			if err := totals[i].Sum.Add(match.ID); err != nil {
+				return nil, fmt.Errorf("range %s: %w", r, err)
+			}
+		}
+	}
+	return totals, nil
+}
+
+// WriteMatches prints every match, such as "1212 in 1200-1300: 12 x 2",
+// followed by their total
+func WriteMatches(w io.Writer, matches iter.Seq[Match]) error {
+	count, sum := 0, Sum{}
+	for match := range matches {
+		if _, err := fmt.Fprintf(w, "%d in %s: %d x %d\n", match.ID, match.Range, match.Pattern, match.Repeats); err != nil {
+			return err
+		}
+		count++
+		if err := sum.Add(match.ID); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Total: %d invalid IDs, sum %s\n", count, sum)
+	return err
+}
+
+// WritePerRange prints a line per range, followed by the overall total
+func WritePerRange(w io.Writer, totals []RangeTotal) error {
+	count, sum := 0, Sum{}
+	for _, total := range totals {
+		if _, err := fmt.Fprintf(w, "%s: %d invalid IDs, sum %s\n", total.Range, total.Count, total.Sum); err != nil {
+			return err
+		}
+		count += total.Count
+		if err := sum.AddSum(total.Sum); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Total: %d invalid IDs, sum %s\n", count, sum)
+	return err
+}
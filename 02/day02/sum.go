@@ -0,0 +1,49 @@
+package day02
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// ErrOverflow is returned when a sum of IDs no longer fits in 128 bits
+var ErrOverflow = errors.New("sum of invalid IDs overflows 128 bits")
+
+// Sum is a 128-bit total of uint64 IDs, so adding up a range of large IDs
+// can't silently wrap
+type Sum struct {
+	hi, lo uint64
+}
+
+// Add adds n to the sum, or returns ErrOverflow leaving it unchanged
+func (s *Sum) Add(n uint64) error {
+	lo, carry := bits.Add64(s.lo, n, 0)
+	hi, carry := bits.Add64(s.hi, 0, carry)
+	if carry != 0 {
+		return ErrOverflow
+	}
+	s.hi, s.lo = hi, lo
+	return nil
+}
+
+// AddSum adds t to the sum, or returns ErrOverflow leaving it unchanged
+func (s *Sum) AddSum(t Sum) error {
+	lo, carry := bits.Add64(s.lo, t.lo, 0)
+	hi, carry := bits.Add64(s.hi, t.hi, carry)
+	if carry != 0 {
+		return ErrOverflow
+	}
+	s.hi, s.lo = hi, lo
+	return nil
+}
+
+// Big returns the sum as a big.Int
+func (s Sum) Big() *big.Int {
+	n := new(big.Int).SetUint64(s.hi)
+	n.Lsh(n, 64)
+	return n.Or(n, new(big.Int).SetUint64(s.lo))
+}
+
+func (s Sum) String() string {
+	return s.Big().String()
+}
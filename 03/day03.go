@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"advent-of-code-2025/03/day03"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	var ks []int
+	flag.Func("k", "total the banks with `k[,k...]` batteries turned on in each instead (e.g. 2,12); may be repeated", func(value string) error {
+		for field := range strings.SplitSeq(value, ",") {
+			k, err := strconv.Atoi(field)
+			if err != nil || k < 1 {
+				return fmt.Errorf("invalid battery count %q", field)
+			}
+			ks = append(ks, k)
+		}
+		return nil
+	})
+	var selector day03.Selector
+	flag.BoolVar(&selector.Minimize, "min", false, "pick the smallest joltage of each bank instead of the largest")
+	flag.BoolVar(&selector.NoLeadingZero, "no-leading-zero", false, "never turn on a 0 as the first battery")
+	flag.BoolVar(&selector.NonAdjacent, "non-adjacent", false, "never turn on two batteries side by side")
+	show := flag.Bool("show", false, "print the batteries turned on in each bank, with their indices")
+	var report string
+	flag.Func("report", "print the batteries turned on in each bank as a `format` instead: csv or json", func(value string) error {
+		if value != "csv" && value != "json" {
+			return fmt.Errorf("unknown report format %q (want csv or json)", value)
+		}
+		report = value
+		return nil
+	})
+	aoc.ParseFlags()
+
+	if len(ks) == 0 && (*show || report != "" || selector != day03.Selector{}) {
+		ks = []int{day03.Part1Size, day03.Part2Size}
+	}
+	if len(ks) > 0 {
+		aoc.Mode(3, func(input []byte) error {
+			banks, err := day03.Parse(bytes.NewReader(input))
+			if err != nil {
+				return err
+			}
+			if *show || report != "" {
+				selections, err := day03.Selections(banks, ks, selector)
+				if err != nil {
+					return err
+				}
+				switch report {
+				case "csv":
+					return day03.WriteCSV(os.Stdout, selections)
+				case "json":
+					return day03.WriteJSON(os.Stdout, selections)
+				}
+				writeSelections(banks, selections)
+			}
+			totals, err := day03.TotalJoltages(banks, ks, selector)
+			if err != nil {
+				return err
+			}
+			for i, k := range ks {
+				fmt.Printf("k=%d: %s\n", k, totals[i])
+			}
+			return nil
+		})
+		return
+	}
+	aoc.Main(3, day03.Solver{})
+}
+
+// writeSelections prints the batteries turned on in each bank for each k,
+// such as "1 k=2: 98............. 98 at [0 1]"
+func writeSelections(banks day03.Input, selections []day03.Selection) {
+	for _, s := range selections {
+		bank := banks[s.Line-1]
+		fmt.Printf("%d k=%d: %s %s at %v\n", s.Line, s.K, day03.Render(bank, s.Positions), s.Digits, s.Positions)
+	}
+}
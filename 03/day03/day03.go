@@ -0,0 +1,93 @@
+package day03
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+// Input is the list of battery banks, one string of joltage digits each
+type Input []string
+
+// Parse reads one bank of battery joltage digits per line, with no blank
+// lines between them
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	banks := make(Input, len(lines))
+	for i, line := range lines {
+		if line.Done() {
+			return nil, line.Errorf("empty bank")
+		}
+		for !line.Done() {
+			if c := line.Peek(); c < '0' || c > '9' {
+				return nil, line.Errorf("expected digit")
+			}
+			line.Pos++
+		}
+		banks[i] = line.Text
+	}
+	return banks, nil
+}
+
+// Part1Size and Part2Size are how many batteries each part turns on per bank
+const (
+	Part1Size = 2
+	Part2Size = 12
+)
+
+type Solver struct{}
+
+func (Solver) Part1(input []byte) (any, error) {
+	return solve(input, Part1Size)
+}
+
+func (Solver) Part2(input []byte) (any, error) {
+	return solve(input, Part2Size)
+}
+
+func solve(input []byte, k int) (any, error) {
+	banks, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	totals, err := TotalJoltages(banks, []int{k}, Selector{})
+	if err != nil {
+		return nil, err
+	}
+	return totals[0].Value(), nil
+}
+
+// TotalJoltages finds the joltage the selector picks from each bank with each
+// number of batteries in ks, in a single pass over the banks, and returns the
+// total for each k
+func TotalJoltages(banks Input, ks []int, selector Selector) ([]Total, error) {
+	totals := make([]Total, len(ks))
+	for line, bank := range banks {
+		for i, k := range ks {
+			indices, err := selector.Select(bank, k)
+			if err != nil {
+				return nil, fmt.Errorf("bank %d: %w", line+1, err)
+			}
+			if err := totals[i].Add(Joltage(bank, indices)); err != nil {
+				return nil, fmt.Errorf("bank %d: %w", line+1, err)
+			}
+		}
+	}
+	return totals, nil
+}
+
+// MaxSubsequence returns the largest number made of k of the digits, kept in
+// order, or all of them if there are no more than k. It runs in
+// O(len(digits)) with a monotonic stack (see Selector.stack).
+func MaxSubsequence(digits string, k int) string {
+	if k <= 0 {
+		return ""
+	}
+	return Joltage(digits, Selector{}.stack(digits, min(k, len(digits))))
+}
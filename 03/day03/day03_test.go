@@ -0,0 +1,243 @@
+package day03
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{}, "../sample.txt", "357", "3121910778619"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"987\n811\n", ""},
+		{"987\n\n811\n", "2:1: empty bank"},
+		{"987\n8x1\n", "2:2: expected digit"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if tt.err == "" && err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+		} else if tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestMaxSubsequence(t *testing.T) {
+	tests := []struct {
+		digits string
+		k      int
+		want   string
+	}{
+		{"987654321111111", 2, "98"},
+		{"811111111111119", 2, "89"},
+		{"234234234234278", 2, "78"},
+		{"818181911112111", 2, "92"},
+		{"987654321111111", 12, "987654321111"},
+		{"811111111111119", 12, "811111111119"},
+		{"234234234234278", 12, "434234234278"},
+		{"818181911112111", 12, "888911112111"},
+		// ties keep the leftmost digit so later picks keep the most choice
+		{"8181", 2, "88"},
+		{"9199", 3, "999"},
+		{"5", 1, "5"},
+		{"5", 3, "5"},
+		{"123", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaxSubsequence(tt.digits, tt.k); got != tt.want {
+			t.Errorf("MaxSubsequence(%q, %d) = %q, want %q", tt.digits, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestTotalJoltages(t *testing.T) {
+	banks := Input{"987654321111111", "811111111111119", "234234234234278", "818181911112111"}
+	got, err := TotalJoltages(banks, []int{2, 12, 1}, Selector{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []any{357, 3121910778619, 9 + 9 + 8 + 9}; got[0].Value() != want[0] || got[1].Value() != want[1] || got[2].Value() != want[2] {
+		t.Errorf("TotalJoltages = %v, want %v", got, want)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	var total Total
+	total.Add("9223372036854775000")
+	total.Add("800")
+	if total.Overflowed() {
+		t.Fatalf("Total %s overflowed early", total)
+	}
+	total.Add("8")
+	if !total.Overflowed() || total.String() != "9223372036854775808" {
+		t.Errorf("Total = %s (overflowed %t), want 9223372036854775808", total, total.Overflowed())
+	}
+
+	for _, bad := range []string{"", "-1", "12a"} {
+		var invalid Total
+		if err := invalid.Add(bad); err == nil {
+			t.Errorf("Total.Add(%q) succeeded", bad)
+		}
+	}
+
+	// a joltage too long for an int on its own
+	var long Total
+	long.Add("1")
+	long.Add("123456789012345678901234567890")
+	if got := long.String(); got != "123456789012345678901234567891" {
+		t.Errorf("Total = %s, want 123456789012345678901234567891", got)
+	}
+	if _, ok := long.Value().(*big.Int); !ok {
+		t.Errorf("Total.Value() = %T, want *big.Int", long.Value())
+	}
+}
+
+func TestReport(t *testing.T) {
+	selections, err := Selections(Input{"987654321111111", "811111111111119"}, []int{2, 3}, Selector{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var csv strings.Builder
+	if err := WriteCSV(&csv, selections); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := "line,k,digits,positions\n1,2,98,0 1\n1,3,987,0 1 2\n2,2,89,0 14\n2,3,819,0 1 14\n"
+	if csv.String() != wantCSV {
+		t.Errorf("WriteCSV =\n%s\nwant\n%s", csv.String(), wantCSV)
+	}
+
+	var out strings.Builder
+	if err := WriteJSON(&out, selections[:1]); err != nil {
+		t.Fatal(err)
+	}
+	var got []Selection
+	if err := json.Unmarshal([]byte(out.String()), &got); err != nil || len(got) != 1 || !slices.Equal(got[0].Positions, []int{0, 1}) || got[0].Digits != "98" {
+		t.Errorf("WriteJSON = %s", out.String())
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		bank     string
+		k        int
+		selector Selector
+		want     []int
+	}{
+		{"818181911112111", 2, Selector{}, []int{6, 11}},
+		{"818181911112111", 3, Selector{Minimize: true}, []int{1, 3, 5}},
+		{"8101", 2, Selector{Minimize: true}, []int{2, 3}},
+		{"8101", 2, Selector{Minimize: true, NoLeadingZero: true}, []int{1, 2}},
+		{"0090", 1, Selector{NoLeadingZero: true}, []int{2}},
+		{"9981", 2, Selector{NonAdjacent: true}, []int{0, 2}},
+		{"98989", 3, Selector{NonAdjacent: true}, []int{0, 2, 4}},
+		{"12345", 2, Selector{Minimize: true, NonAdjacent: true}, []int{0, 2}},
+		{"123", 5, Selector{}, []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.selector.Select(tt.bank, tt.k)
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("%+v.Select(%q, %d) = %v, %v, want %v", tt.selector, tt.bank, tt.k, got, err, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		bank     string
+		k        int
+		selector Selector
+	}{
+		{"123", 3, Selector{NonAdjacent: true}},
+		{"000", 1, Selector{Minimize: true, NoLeadingZero: true}},
+	} {
+		if got, err := tt.selector.Select(tt.bank, tt.k); err == nil {
+			t.Errorf("%+v.Select(%q, %d) = %v, want an error", tt.selector, tt.bank, tt.k, got)
+		}
+	}
+}
+
+// TestSelectExhaustive checks every selector against trying every choice of
+// batteries in small random banks
+func TestSelectExhaustive(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 3))
+	for range 300 {
+		bank := make([]byte, 1+rng.IntN(10))
+		for i := range bank {
+			bank[i] = byte('0' + rng.IntN(4))
+		}
+		k := 1 + rng.IntN(len(bank))
+		for _, selector := range []Selector{
+			{}, {Minimize: true}, {Minimize: true, NoLeadingZero: true},
+			{NonAdjacent: true}, {Minimize: true, NonAdjacent: true}, {Minimize: true, NoLeadingZero: true, NonAdjacent: true},
+		} {
+			want, ok := bestChoice(string(bank), k, selector)
+			got, err := selector.Select(string(bank), k)
+			if !ok {
+				if err == nil {
+					t.Errorf("%+v.Select(%q, %d) = %v, want an error", selector, bank, k, got)
+				}
+				continue
+			}
+			if err != nil || Joltage(string(bank), got) != want {
+				t.Errorf("%+v.Select(%q, %d) = %v, %v, want joltage %s", selector, bank, k, got, err, want)
+			}
+		}
+	}
+}
+
+// bestChoice tries every set of k indices of the bank
+func bestChoice(bank string, k int, selector Selector) (string, bool) {
+	best, found := "", false
+	for mask := range 1 << len(bank) {
+		if bits.OnesCount(uint(mask)) != k {
+			continue
+		}
+		if selector.NonAdjacent && mask&(mask>>1) != 0 {
+			continue
+		}
+		var digits []byte
+		for i := range len(bank) {
+			if mask&(1<<i) != 0 {
+				digits = append(digits, bank[i])
+			}
+		}
+		if selector.NoLeadingZero && digits[0] == '0' {
+			continue
+		}
+		// the digits all have length k, so compare like numbers
+		if d := string(digits); !found || selector.Minimize && d < best || !selector.Minimize && d > best {
+			best, found = d, true
+		}
+	}
+	return best, found
+}
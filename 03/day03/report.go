@@ -0,0 +1,57 @@
+package day03
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Selection is the batteries turned on in one bank for one k
+type Selection struct {
+	Line      int    `json:"line"` // counting from 1
+	K         int    `json:"k"`
+	Digits    string `json:"digits"`
+	Positions []int  `json:"positions"` // indices into the bank, counting from 0
+}
+
+// Selections runs the selector over each bank with each k, in bank order
+func Selections(banks Input, ks []int, selector Selector) ([]Selection, error) {
+	var selections []Selection
+	for line, bank := range banks {
+		for _, k := range ks {
+			indices, err := selector.Select(bank, k)
+			if err != nil {
+				return nil, fmt.Errorf("bank %d: %w", line+1, err)
+			}
+			selections = append(selections, Selection{line + 1, k, Joltage(bank, indices), indices})
+		}
+	}
+	return selections, nil
+}
+
+// WriteCSV prints the selections with a header row, positions separated by
+// spaces in a single column
+func WriteCSV(w io.Writer, selections []Selection) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"line", "k", "digits", "positions"})
+	for _, s := range selections {
+		positions := make([]string, len(s.Positions))
+		for i, p := range s.Positions {
+			positions[i] = strconv.Itoa(p)
+		}
+		cw.Write([]string{strconv.Itoa(s.Line), strconv.Itoa(s.K), s.Digits, strings.Join(positions, " ")})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON prints the selections as a JSON array
+func WriteJSON(w io.Writer, selections []Selection) error {
+	if selections == nil {
+		selections = []Selection{}
+	}
+	return json.NewEncoder(w).Encode(selections)
+}
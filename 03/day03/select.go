@@ -0,0 +1,124 @@
+package day03
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector chooses which k batteries of a bank to turn on. The zero Selector
+// picks the largest joltage, as both parts do.
+type Selector struct {
+	Minimize      bool // pick the smallest joltage instead
+	NoLeadingZero bool // the first battery chosen can't be a 0
+	NonAdjacent   bool // no two chosen batteries can sit side by side
+}
+
+// Select returns the indices of the k batteries of the bank, in order, that
+// make the best joltage, or all of them if there are no more than k and they
+// needn't be apart. It fails when the constraints leave no choice.
+func (s Selector) Select(bank string, k int) ([]int, error) {
+	if k <= 0 {
+		return nil, nil
+	}
+	if !s.NonAdjacent {
+		k = min(k, len(bank))
+	}
+	if !s.NoLeadingZero && !s.NonAdjacent {
+		return s.stack(bank, k), nil
+	}
+	return s.scan(bank, k)
+}
+
+// better reports whether digit a beats digit b
+func (s Selector) better(a, b byte) bool {
+	if s.Minimize {
+		return a < b
+	}
+	return a > b
+}
+
+// stack keeps the indices chosen so far on a stack, each new digit popping
+// the worse ones off the top while enough digits remain after it to fill the
+// stack again. Every index is pushed and popped at most once, so this is
+// O(len(bank)).
+func (s Selector) stack(bank string, k int) []int {
+	stack := make([]int, 0, k)
+	for i := range len(bank) {
+		remaining := len(bank) - i
+		// ties stay on the stack, so later picks keep the most choice
+		for len(stack) > 0 && s.better(bank[i], bank[stack[len(stack)-1]]) && len(stack)-1+remaining >= k {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) < k {
+			stack = append(stack, i)
+		}
+	}
+	return stack
+}
+
+// scan picks one battery at a time, the best digit that still leaves room
+// for the rest to be chosen after it, taking the leftmost of equal digits so
+// later picks keep the most choice. This is O(len(bank) * k).
+func (s Selector) scan(bank string, k int) ([]int, error) {
+	gap := 1
+	if s.NonAdjacent {
+		gap = 2
+	}
+	// fits is how many batteries can still be chosen from index i onwards
+	fits := func(i int) int {
+		return max(0, (len(bank)-i+gap-1)/gap)
+	}
+	if fits(0) < k {
+		return nil, fmt.Errorf("can't choose %d batteries from %d %s", k, len(bank), s.constraint())
+	}
+
+	indices := make([]int, 0, k)
+	for next := 0; len(indices) < k; {
+		best := -1
+		for i := next; i < len(bank) && fits(i+gap) >= k-len(indices)-1; i++ {
+			if len(indices) == 0 && s.NoLeadingZero && bank[i] == '0' {
+				continue
+			}
+			if best < 0 || s.better(bank[i], bank[best]) {
+				best = i
+			}
+		}
+		if best < 0 {
+			return nil, fmt.Errorf("can't choose %d batteries from %s %s", k, bank, s.constraint())
+		}
+		indices = append(indices, best)
+		next = best + gap
+	}
+	return indices, nil
+}
+
+// constraint describes the constraints for error messages
+func (s Selector) constraint() string {
+	var parts []string
+	if s.NonAdjacent {
+		parts = append(parts, "with none adjacent")
+	}
+	if s.NoLeadingZero {
+		parts = append(parts, "without a leading zero")
+	}
+	return strings.Join(parts, " and ")
+}
+
+// Joltage returns the digits of the bank at the indices
+func Joltage(bank string, indices []int) string {
+	digits := make([]byte, len(indices))
+	for i, index := range indices {
+		digits[i] = bank[index]
+	}
+	return string(digits)
+}
+
+// Render shows which batteries of the bank are on, replacing the others with
+// '.', such as "9...8" for indices 0 and 4 of "91118"
+func Render(bank string, indices []int) string {
+	shown := []byte(strings.Repeat(".", len(bank)))
+	for _, index := range indices {
+		shown[index] = bank[index]
+	}
+	return string(shown)
+}
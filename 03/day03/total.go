@@ -0,0 +1,55 @@
+package day03
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Total adds up joltages as an int, switching to a big.Int once a joltage or
+// the sum no longer fits, so large k or many banks can't silently overflow
+type Total struct {
+	small int
+	big   *big.Int // set once the total has overflowed an int
+}
+
+// Add adds a joltage, given as its digits, failing if they aren't a
+// non-negative number
+func (t *Total) Add(digits string) error {
+	if t.big == nil {
+		if n, err := strconv.Atoi(digits); err == nil && n >= 0 && n <= math.MaxInt-t.small {
+			t.small += n
+			return nil
+		}
+	}
+	n, ok := new(big.Int).SetString(digits, 10)
+	if !ok || n.Sign() < 0 {
+		return fmt.Errorf("invalid joltage %q", digits)
+	}
+	if t.big == nil {
+		t.big = big.NewInt(int64(t.small))
+	}
+	t.big.Add(t.big, n)
+	return nil
+}
+
+// Overflowed reports whether the total no longer fits in an int
+func (t Total) Overflowed() bool {
+	return t.big != nil
+}
+
+// Value returns the total as an int, or a *big.Int once it has overflowed
+func (t Total) Value() any {
+	if t.big != nil {
+		return t.big
+	}
+	return t.small
+}
+
+func (t Total) String() string {
+	if t.big != nil {
+		return t.big.String()
+	}
+	return strconv.Itoa(t.small)
+}
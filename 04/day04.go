@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"advent-of-code-2025/04/day04"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	var solver day04.Solver
+	flag.Func("mode", "how removals in a pass affect each other: sweep (row by row, each row seeing removals from the rows above; the default) or simultaneous (generation by generation)", func(value string) error {
+		solver.Mode = day04.Mode(value)
+		if solver.Mode != day04.Sweep && solver.Mode != day04.Simultaneous {
+			return fmt.Errorf("unknown mode %q (want %s or %s)", value, day04.Sweep, day04.Simultaneous)
+		}
+		return nil
+	})
+	flag.Func("neighbourhood", "cells counted as neighbours: von-neumann, moore (the default), hex, or a `stencil` such as .#./#o#/.#.", func(value string) error {
+		neighbourhood, err := day04.ParseNeighbourhood(value)
+		solver.Rule.Neighbourhood = neighbourhood
+		return err
+	})
+	flag.Func("threshold", "how a roll's neighbour count makes it accessible, such as <4 (the default) or >=2", func(value string) error {
+		var err error
+		solver.Rule.Compare, solver.Rule.Threshold, err = day04.ParseThreshold(value)
+		return err
+	})
+	flag.BoolVar(&solver.Rule.Wrap, "wrap", false, "wrap neighbours around the edges of the map, as on a torus")
+	timeline := flag.Bool("timeline", false, "print the generation each roll was removed in, the rolls removed per generation and the final map instead, removing simultaneously unless -mode is given")
+	aoc.ParseFlags()
+
+	if *timeline {
+		aoc.Mode(4, func(input []byte) error {
+			t, err := solver.Timeline(input)
+			if err != nil {
+				return err
+			}
+			return day04.WriteTimeline(os.Stdout, t)
+		})
+		return
+	}
+	aoc.Main(4, &solver)
+}
@@ -0,0 +1,71 @@
+package day04
+
+import (
+	"bytes"
+	"io"
+	"slices"
+
+	"advent-of-code-2025/internal/grid"
+)
+
+// Input is the map of paper rolls: '@' for a roll, '.' for an empty cell
+type Input = grid.Grid
+
+// Parse reads the map of paper rolls, one row per line
+func Parse(r io.Reader) (Input, error) {
+	return grid.Parse(r, ".@")
+}
+
+type Solver struct {
+	// Mode is how removals within a pass affect each other, Sweep if unset
+	Mode Mode
+	// Rule decides which rolls are accessible, the puzzle's if unset
+	Rule Rule
+}
+
+// Variant reports whether the solver's rule differs from the puzzle's, fewer
+// than four rolls among the eight surrounding cells
+func (s Solver) Variant() bool {
+	r := s.Rule.orDefault()
+	return !slices.Equal(r.Neighbourhood, Moore) || r.Compare != Less || r.Threshold != 4 || r.Wrap
+}
+
+// Part1 counts the rolls accessible before any are removed, the first
+// generation of a Simultaneous removal
+func (s Solver) Part1(input []byte) (any, error) {
+	timeline, err := solve(input, Simultaneous, s.Rule)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeline.Counts) == 0 {
+		return 0, nil
+	}
+	return timeline.Counts[0], nil
+}
+
+func (s Solver) Part2(input []byte) (any, error) {
+	timeline, err := solve(input, s.Mode, s.Rule)
+	if err != nil {
+		return nil, err
+	}
+	return timeline.Total(), nil
+}
+
+// Timeline removes the rolls of the input by the solver's Mode if one is set,
+// or else generation by generation, so that its first generation is Part1's
+// answer
+func (s Solver) Timeline(input []byte) (Timeline, error) {
+	mode := s.Mode
+	if mode == "" {
+		mode = Simultaneous
+	}
+	return solve(input, mode, s.Rule)
+}
+
+func solve(input []byte, mode Mode, rule Rule) (Timeline, error) {
+	g, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return Timeline{}, err
+	}
+	return Remove(g, mode, rule)
+}
@@ -0,0 +1,305 @@
+package day04
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+	"advent-of-code-2025/internal/grid"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{}, "../sample.txt", "13", "43"},
+		{"sample simultaneous", Solver{Mode: Simultaneous}, "../sample.txt", "13", "43"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	input := aoctest.ReadInput(t, "../sample.txt")
+	for _, solver := range []Solver{{}, {Rule: Rule{Neighbourhood: VonNeumann, Compare: Less, Threshold: 2}}} {
+		timeline, err := solver.Timeline(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part1, err := solver.Part1(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if timeline.Counts[0] != part1 {
+			t.Errorf("%+v.Timeline(sample) generation 1 removes %d, want Part1's %v", solver, timeline.Counts[0], part1)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	g := sampleGrid(t)
+	timeline, err := Remove(g, Simultaneous, Rule{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{13, 12, 7, 5, 2, 1, 1, 1, 1}; !slices.Equal(timeline.Counts, want) {
+		t.Errorf("Remove(sample, Simultaneous).Counts = %v, want %v", timeline.Counts, want)
+	}
+
+	// the passes of the original row-buffered sweep
+	sweep, err := Remove(sampleGrid(t), Sweep, Rule{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{27, 8, 4, 2, 2}; !slices.Equal(sweep.Counts, want) {
+		t.Errorf("Remove(sample, Sweep).Counts = %v, want %v", sweep.Counts, want)
+	}
+	wantGenerations := `..11.1121.
+134.2.2.32
+24578.1.33
+2.69@@..2.
+13.@@@@.21
+.24@@@@@.2
+.2.@.@.@@3
+1.4@@.@@@4
+.23@@@@@5.
+1.1.@@@.1.`
+	if got := timeline.GenerationGrid(); got != wantGenerations {
+		t.Errorf("GenerationGrid =\n%s\nwant\n%s", got, wantGenerations)
+	}
+
+	if _, err := Remove(g, "random", Rule{}); err == nil {
+		t.Error("Remove with an unknown mode succeeded")
+	}
+}
+
+// TestRemoveWrapSmall checks grids narrower than the neighbourhood, where
+// several offsets wrap onto the same cell or back onto the roll itself
+func TestRemoveWrapSmall(t *testing.T) {
+	isolated := Rule{Compare: Less, Threshold: 1, Wrap: true}
+	tests := []struct {
+		input  string
+		rule   Rule
+		counts []int
+	}{
+		{"@\n", Rule{Wrap: true}, []int{1}},
+		{"@@\n", Rule{Compare: Less, Threshold: 2, Wrap: true}, []int{2}},
+		{"@.@@.\n", isolated, []int{1}},
+		{"@\n.\n@\n@\n.\n", isolated, []int{1}},
+		{"@.@@.\n", Rule{Neighbourhood: Hex, Compare: Less, Threshold: 1, Wrap: true}, []int{1}},
+	}
+
+	for _, tt := range tests {
+		g, err := Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		timeline, err := Remove(g, Simultaneous, tt.rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(timeline.Counts, tt.counts) {
+			t.Errorf("Remove(%q, %+v).Counts = %v, want %v", tt.input, tt.rule, timeline.Counts, tt.counts)
+		}
+	}
+	aoctest.CheckPart(t, "Part1", Solver{Rule: Rule{Wrap: true}}.Part1, []byte("@\n"), "1")
+}
+
+// TestRemoveRescanning checks both modes with a variety of rules against
+// rescanning every cell of random grids each pass
+func TestRemoveRescanning(t *testing.T) {
+	knight, err := ParseNeighbourhood(".#.#./#...#/..o../#...#/.#.#.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lopsided, err := ParseNeighbourhood("o#/##")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := []Rule{
+		{},
+		{Wrap: true},
+		{Neighbourhood: VonNeumann, Compare: Less, Threshold: 2},
+		{Neighbourhood: Hex, Compare: LessEqual, Threshold: 2, Wrap: true},
+		{Neighbourhood: knight, Compare: Less, Threshold: 3},
+		{Neighbourhood: lopsided, Compare: Less, Threshold: 2, Wrap: true},
+		// counts only fall, but these rules don't favour fewer neighbours
+		{Compare: GreaterEqual, Threshold: 5},
+		{Compare: Equal, Threshold: 3},
+		{Neighbourhood: VonNeumann, Compare: NotEqual, Threshold: 2, Wrap: true},
+	}
+
+	rng := rand.New(rand.NewPCG(4, 4))
+	for range 500 {
+		g := make(grid.Grid, 1+rng.IntN(12))
+		width := 1 + rng.IntN(12)
+		for row := range g {
+			g[row] = make([]byte, width)
+			for col := range g[row] {
+				g[row][col] = ".@@"[rng.IntN(3)]
+			}
+		}
+
+		rule := rules[rng.IntN(len(rules))]
+		for _, mode := range []Mode{Sweep, Simultaneous} {
+			want := clone(g)
+			wantRemoved := removeByRescanning(want, mode, rule.orDefault())
+			got := clone(g)
+			timeline, err := Remove(got, mode, rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(timeline.Counts, wantRemoved) || timeline.Final.String() != want.String() {
+				t.Errorf("Remove(%s, %+v) of\n%s\n= %v, want %v", mode, rule, g, timeline.Counts, wantRemoved)
+			}
+			histogram := make([]int, len(timeline.Counts))
+			for _, generations := range timeline.Generations {
+				for _, generation := range generations {
+					if generation > 0 {
+						histogram[generation-1]++
+					}
+				}
+			}
+			if !slices.Equal(histogram, timeline.Counts) {
+				t.Errorf("Remove(%s) generations add up to %v, want %v", mode, histogram, timeline.Counts)
+			}
+		}
+	}
+}
+
+// removeByRescanning checks every cell each pass. For a Sweep it rescans as
+// the original removal did, checking each row against the grid as it was
+// when the row began and writing the row back once it's done.
+func removeByRescanning(g grid.Grid, mode Mode, rule Rule) []int {
+	var removed []int
+	for {
+		var accessible []grid.Point
+		for row := range g {
+			for col := range g[row] {
+				p := grid.Point{Row: row, Col: col}
+				if g.At(p) != '@' {
+					continue
+				}
+				// each distinct cell other than p counts once
+				count := 0
+				counted := map[grid.Point]bool{p: true}
+				for _, d := range rule.Neighbourhood {
+					q := grid.Point{Row: row + d.Row, Col: col + d.Col}
+					if rule.Wrap {
+						q.Row = (q.Row + 3*len(g)) % len(g)
+						q.Col = (q.Col + 3*len(g[row])) % len(g[row])
+					}
+					if g.In(q) && !counted[q] && g.At(q) == '@' {
+						count++
+					}
+					counted[q] = true
+				}
+				if !rule.accessible(count) {
+					continue
+				}
+				accessible = append(accessible, p)
+			}
+			// a sweep writes each row back once it's been checked
+			if mode == Sweep {
+				for _, p := range accessible {
+					g.Set(p, '.')
+				}
+			}
+		}
+		if len(accessible) == 0 {
+			return removed
+		}
+		for _, p := range accessible {
+			g.Set(p, '.')
+		}
+		removed = append(removed, len(accessible))
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	vonNeumann, err := ParseNeighbourhood(".#./#o#/.#.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.SortFunc(vonNeumann, func(a, b grid.Point) int { return (a.Row-b.Row)*10 + a.Col - b.Col })
+	if !slices.Equal(vonNeumann, VonNeumann) {
+		t.Errorf("ParseNeighbourhood(.#./#o#/.#.) = %v, want %v", vonNeumann, VonNeumann)
+	}
+	for _, bad := range []string{"###/###", "o#o", ".#x/.o.", "knight"} {
+		if _, err := ParseNeighbourhood(bad); err == nil {
+			t.Errorf("ParseNeighbourhood(%q) succeeded", bad)
+		}
+	}
+
+	thresholds := []struct {
+		s         string
+		compare   Comparison
+		threshold int
+	}{
+		{"<4", Less, 4},
+		{"<=3", LessEqual, 3},
+		{">= 2", GreaterEqual, 2},
+		{"==0", Equal, 0},
+		{"!=8", NotEqual, 8},
+	}
+	for _, tt := range thresholds {
+		compare, threshold, err := ParseThreshold(tt.s)
+		if err != nil || compare != tt.compare || threshold != tt.threshold {
+			t.Errorf("ParseThreshold(%q) = %q, %d, %v, want %q, %d", tt.s, compare, threshold, err, tt.compare, tt.threshold)
+		}
+	}
+	for _, bad := range []string{"4", "<", "=<4", "<x"} {
+		if _, _, err := ParseThreshold(bad); err == nil {
+			t.Errorf("ParseThreshold(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestVariant(t *testing.T) {
+	tests := []struct {
+		solver  Solver
+		variant bool
+	}{
+		{Solver{}, false},
+		{Solver{Mode: Simultaneous}, false},
+		{Solver{Rule: Rule{Neighbourhood: Moore, Compare: Less, Threshold: 4}}, false},
+		{Solver{Rule: Rule{Neighbourhood: Hex}}, true},
+		{Solver{Rule: Rule{Compare: Less, Threshold: 3}}, true},
+		{Solver{Rule: Rule{Wrap: true}}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.solver.Variant(); got != tt.variant {
+			t.Errorf("%+v.Variant() = %t, want %t", tt.solver, got, tt.variant)
+		}
+	}
+}
+
+func sampleGrid(t *testing.T) grid.Grid {
+	t.Helper()
+	g, err := Parse(bytes.NewReader(aoctest.ReadInput(t, "../sample.txt")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func clone(g grid.Grid) grid.Grid {
+	c := make(grid.Grid, len(g))
+	for row := range g {
+		c[row] = slices.Clone(g[row])
+	}
+	return c
+}
@@ -0,0 +1,259 @@
+package day04
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"advent-of-code-2025/internal/grid"
+	"advent-of-code-2025/internal/mathx"
+)
+
+// Mode is how removing rolls affects the rest of the pass removing them
+type Mode string
+
+const (
+	// Sweep checks the rolls row by row, each row seeing the rolls removed
+	// from the rows above it in the same pass but not those removed earlier in
+	// its own row, and passes again until one removes nothing
+	Sweep Mode = "sweep"
+	// Simultaneous removes every accessible roll at once, generation by
+	// generation like a cellular automaton
+	Simultaneous Mode = "simultaneous"
+)
+
+// Timeline records when each roll was removed. A generation is a pass of a
+// Sweep, or a step of a Simultaneous removal, counting from 1.
+type Timeline struct {
+	Generations [][]int   // the generation each cell was removed in, 0 if it wasn't
+	Counts      []int     // how many rolls each generation removed, from generation 1
+	Final       grid.Grid // the rolls left once no more are accessible
+}
+
+// Total is how many rolls were removed altogether
+func (t Timeline) Total() int {
+	return mathx.Sum[int](t.Counts)
+}
+
+// Remove clears the rolls the rule finds accessible from g until none are
+// left, recording which generation removed each. g is left as the final grid.
+//
+// Rather than rescanning the grid every pass, it keeps the number of
+// neighbouring rolls of every roll and only looks again at the neighbours of
+// the rolls it removes, so a Simultaneous removal costs O(cells). A Sweep
+// keeps the rolls still to check in row order on a heap, so costs
+// O(cells log cells).
+func Remove(g grid.Grid, mode Mode, rule Rule) (Timeline, error) {
+	r := newRemoval(g, rule.orDefault())
+	var counts []int
+	switch mode {
+	case Sweep, "":
+		counts = r.sweep()
+	case Simultaneous:
+		counts = r.simultaneous()
+	default:
+		return Timeline{}, fmt.Errorf("unknown mode %q (want %s or %s)", mode, Sweep, Simultaneous)
+	}
+
+	generations := make([][]int, g.Height())
+	for row := range generations {
+		generations[row] = r.removedIn[row*r.width : (row+1)*r.width]
+	}
+	return Timeline{Generations: generations, Counts: counts, Final: g}, nil
+}
+
+// removal tracks the rolls of a grid as they are removed. Cells are numbered
+// row by row, so that their numbers follow the order a sweep visits them.
+type removal struct {
+	g         grid.Grid
+	rule      Rule
+	width     int
+	reverse   []grid.Point // the neighbourhood's offsets turned around
+	counts    []int        // neighbouring rolls of each roll
+	queued    []int        // the generation each cell was last queued for
+	removedIn []int        // the generation each cell was removed in
+}
+
+func newRemoval(g grid.Grid, rule Rule) *removal {
+	r := &removal{
+		g:         g,
+		rule:      rule,
+		width:     g.Width(),
+		counts:    make([]int, g.Height()*g.Width()),
+		queued:    make([]int, g.Height()*g.Width()),
+		removedIn: make([]int, g.Height()*g.Width()),
+		reverse:   make([]grid.Point, len(rule.Neighbourhood)),
+	}
+	for i, d := range rule.Neighbourhood {
+		r.reverse[i] = grid.Point{Row: -d.Row, Col: -d.Col}
+	}
+	for i := range r.counts {
+		p := r.point(i)
+		if g.At(p) != '@' {
+			continue
+		}
+		rule.neighbours(g, p, rule.Neighbourhood, func(q grid.Point) {
+			if g.At(q) == '@' {
+				r.counts[i]++
+			}
+		})
+	}
+	return r
+}
+
+func (r *removal) point(i int) grid.Point {
+	return grid.Point{Row: i / r.width, Col: i % r.width}
+}
+
+// accessible reports whether cell i holds a roll the rule finds accessible
+func (r *removal) accessible(i int) bool {
+	return r.g.At(r.point(i)) == '@' && r.rule.accessible(r.counts[i])
+}
+
+// initial queues the rolls that are accessible before any are removed
+func (r *removal) initial() []int {
+	var rolls []int
+	for i := range r.counts {
+		if r.accessible(i) {
+			r.queued[i] = 1
+			rolls = append(rolls, i)
+		}
+	}
+	return rolls
+}
+
+// remove clears roll i and calls changed with each roll that counted it as a
+// neighbour, after taking it off their counts
+func (r *removal) remove(i int, changed func(n int)) {
+	r.g.Set(r.point(i), '.')
+	r.neighbours(i, func(n int) {
+		r.counts[n]--
+		changed(n)
+	})
+}
+
+// neighbours calls fn with each roll that counts cell i as a neighbour. Those
+// are the cells i is offset from, which for a lopsided neighbourhood aren't
+// the cells offset from i.
+func (r *removal) neighbours(i int, fn func(n int)) {
+	r.rule.neighbours(r.g, r.point(i), r.reverse, func(q grid.Point) {
+		if r.g.At(q) == '@' {
+			fn(q.Row*r.width + q.Col)
+		}
+	})
+}
+
+// sweep works through each pass in row order. A row is checked against the
+// grid as it was when the row began, so a removal is seen by the rows below
+// it in the same pass, but not by the rest of its own row, nor by the rows
+// above, until the next pass. Only rolls whose counts changed are checked
+// again; counts only go down, but the rule needn't favour fewer neighbours,
+// so each roll is checked again when its turn comes rather than when queued.
+func (r *removal) sweep() []int {
+	var removed []int
+	next := r.initial()
+	for pass := 1; len(next) > 0; pass++ {
+		queue := cellHeap(next)
+		next = nil
+		queueNext := func(n int) {
+			if r.queued[n] < pass+1 {
+				r.queued[n] = pass + 1
+				next = append(next, n)
+			}
+		}
+
+		// the removals in the current row, which only count once it's done
+		row, rowRemoved := -1, []int(nil)
+		finishRow := func() {
+			for _, i := range rowRemoved {
+				r.neighbours(i, func(n int) {
+					if n/r.width == i/r.width {
+						r.counts[n]--
+						queueNext(n)
+					}
+				})
+			}
+			rowRemoved = rowRemoved[:0]
+		}
+
+		count := 0
+		for queue.Len() > 0 {
+			i := heap.Pop(&queue).(int)
+			if i/r.width != row {
+				finishRow()
+				row = i / r.width
+			}
+			if !r.accessible(i) {
+				continue
+			}
+			// rows below see the removal this pass, rows above next pass
+			r.neighbours(i, func(n int) {
+				switch {
+				case n/r.width > row:
+					r.counts[n]--
+					if r.queued[n] < pass {
+						r.queued[n] = pass
+						heap.Push(&queue, n)
+					}
+				case n/r.width < row:
+					r.counts[n]--
+					queueNext(n)
+				}
+			})
+			r.g.Set(r.point(i), '.')
+			rowRemoved = append(rowRemoved, i)
+			r.removedIn[i] = pass
+			count++
+		}
+		finishRow()
+		if count == 0 {
+			break
+		}
+		removed = append(removed, count)
+		slices.Sort(next)
+	}
+	return removed
+}
+
+// simultaneous removes a whole generation before checking which of their
+// neighbours the next generation removes
+func (r *removal) simultaneous() []int {
+	var removed []int
+	current := r.initial()
+	for generation := 1; len(current) > 0; generation++ {
+		current = slices.DeleteFunc(current, func(i int) bool { return !r.accessible(i) })
+		if len(current) == 0 {
+			break
+		}
+		for _, i := range current {
+			r.g.Set(r.point(i), '.')
+			r.removedIn[i] = generation
+		}
+		var next []int
+		for _, i := range current {
+			r.remove(i, func(n int) {
+				if r.queued[n] < generation+1 {
+					r.queued[n] = generation + 1
+					next = append(next, n)
+				}
+			})
+		}
+		removed = append(removed, len(current))
+		current = next
+	}
+	return removed
+}
+
+// cellHeap is a min-heap of cell numbers
+type cellHeap []int
+
+func (h cellHeap) Len() int           { return len(h) }
+func (h cellHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h cellHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *cellHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *cellHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,163 @@
+package day04
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"advent-of-code-2025/internal/grid"
+)
+
+// Rule decides which rolls are accessible: those whose count of neighbouring
+// rolls compares to the threshold. The zero Rule is the puzzle's, fewer than
+// four rolls among the eight surrounding cells.
+type Rule struct {
+	Neighbourhood []grid.Point // offsets of the cells counted, Moore if nil
+	Compare       Comparison   // Less if unset
+	Threshold     int          // 4 if Compare is unset
+	Wrap          bool         // neighbours past an edge wrap around to the other side
+}
+
+// Comparison is how a roll's neighbour count must compare to the threshold
+type Comparison string
+
+const (
+	Less         Comparison = "<"
+	LessEqual    Comparison = "<="
+	Greater      Comparison = ">"
+	GreaterEqual Comparison = ">="
+	Equal        Comparison = "=="
+	NotEqual     Comparison = "!="
+)
+
+// VonNeumann are the four cells sharing an edge with a cell
+var VonNeumann = grid.Orthogonal
+
+// Moore are the eight cells surrounding a cell
+var Moore = grid.Adjacent
+
+// Hex are the six neighbours of a hexagonal cell, with each row drawn half a
+// cell right of the one above, so a cell touches the two above it to its
+// upper left and right and the two below it to its lower left and right
+var Hex = []grid.Point{
+	{Row: -1, Col: 0}, {Row: -1, Col: 1},
+	{Row: 0, Col: -1}, {Row: 0, Col: 1},
+	{Row: 1, Col: -1}, {Row: 1, Col: 0},
+}
+
+// orDefault fills in the puzzle's rule for anything unset
+func (r Rule) orDefault() Rule {
+	if r.Neighbourhood == nil {
+		r.Neighbourhood = Moore
+	}
+	if r.Compare == "" {
+		r.Compare, r.Threshold = Less, 4
+	}
+	return r
+}
+
+// accessible reports whether a roll with count neighbouring rolls is
+// accessible
+func (r Rule) accessible(count int) bool {
+	switch r.Compare {
+	case LessEqual:
+		return count <= r.Threshold
+	case Greater:
+		return count > r.Threshold
+	case GreaterEqual:
+		return count >= r.Threshold
+	case Equal:
+		return count == r.Threshold
+	case NotEqual:
+		return count != r.Threshold
+	}
+	return count < r.Threshold
+}
+
+// neighbours calls fn with each cell of g at one of the offsets from p. With
+// Wrap, a grid no larger than the neighbourhood can wrap several offsets onto
+// the same cell, which is only counted once, or back onto p, which isn't
+// counted at all.
+func (r Rule) neighbours(g grid.Grid, p grid.Point, offsets []grid.Point, fn func(q grid.Point)) {
+	if !r.Wrap {
+		for _, d := range offsets {
+			if q := p.Add(d); g.In(q) {
+				fn(q)
+			}
+		}
+		return
+	}
+
+	var buf [8]grid.Point
+	seen := buf[:0]
+	for _, d := range offsets {
+		q := p.Add(d)
+		q.Row = (q.Row%g.Height() + g.Height()) % g.Height()
+		q.Col = (q.Col%g.Width() + g.Width()) % g.Width()
+		if q == p || slices.Contains(seen, q) {
+			continue
+		}
+		seen = append(seen, q)
+		fn(q)
+	}
+}
+
+// ParseNeighbourhood reads a neighbourhood by name, von-neumann, moore or hex,
+// or as a stencil: rows separated by '/' of '#' for a neighbour, '.' for a
+// cell left out and a single 'o' for the cell itself, such as ".#./#o#/.#."
+// for von Neumann.
+func ParseNeighbourhood(s string) ([]grid.Point, error) {
+	switch s {
+	case "von-neumann":
+		return VonNeumann, nil
+	case "moore":
+		return Moore, nil
+	case "hex":
+		return Hex, nil
+	}
+
+	rows := strings.Split(s, "/")
+	var centre *grid.Point
+	var cells []grid.Point
+	for row, cols := range rows {
+		for col, c := range []byte(cols) {
+			switch c {
+			case '#':
+				cells = append(cells, grid.Point{Row: row, Col: col})
+			case 'o':
+				if centre != nil {
+					return nil, fmt.Errorf("stencil %q has more than one centre 'o'", s)
+				}
+				centre = &grid.Point{Row: row, Col: col}
+			case '.':
+			default:
+				return nil, fmt.Errorf("unknown neighbourhood %q (want von-neumann, moore, hex, or a stencil of '#', '.' and 'o')", s)
+			}
+		}
+	}
+	if centre == nil {
+		return nil, fmt.Errorf("stencil %q has no centre 'o'", s)
+	}
+
+	offsets := make([]grid.Point, len(cells))
+	for i, cell := range cells {
+		offsets[i] = grid.Point{Row: cell.Row - centre.Row, Col: cell.Col - centre.Col}
+	}
+	return offsets, nil
+}
+
+// ParseThreshold reads a comparison and threshold such as "<4" or ">=2"
+func ParseThreshold(s string) (Comparison, int, error) {
+	// try the two-character comparisons first, so "<=" isn't read as "<"
+	for _, c := range []Comparison{LessEqual, GreaterEqual, Equal, NotEqual, Less, Greater} {
+		if rest, ok := strings.CutPrefix(s, string(c)); ok {
+			n, err := strconv.Atoi(strings.TrimSpace(rest))
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid threshold %q", s)
+			}
+			return c, n, nil
+		}
+	}
+	return "", 0, fmt.Errorf("invalid threshold %q (want a comparison <, <=, >, >=, == or != and a number, such as <4)", s)
+}
@@ -0,0 +1,50 @@
+package day04
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// generationMarks label generations 1 to 61 in a generation grid
+const generationMarks = "123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// GenerationGrid renders the map with each removed roll replaced by its
+// generation: 1-9, then a-z for 10-35 and A-Z for 36-61, and '+' after that.
+// Rolls never removed stay '@' and empty cells stay '.'.
+func (t Timeline) GenerationGrid() string {
+	var b strings.Builder
+	for row, generations := range t.Generations {
+		if row > 0 {
+			b.WriteByte('\n')
+		}
+		for col, generation := range generations {
+			switch {
+			case generation == 0:
+				b.WriteByte(t.Final[row][col])
+			case generation <= len(generationMarks):
+				b.WriteByte(generationMarks[generation-1])
+			default:
+				b.WriteByte('+')
+			}
+		}
+	}
+	return b.String()
+}
+
+// WriteTimeline prints the generation grid, a histogram of the rolls each
+// generation removed, and the final grid
+func WriteTimeline(w io.Writer, t Timeline) error {
+	fmt.Fprintf(w, "Generations:\n%s\n\nRemoved per generation:\n", t.GenerationGrid())
+	largest := 0
+	for _, count := range t.Counts {
+		largest = max(largest, count)
+	}
+	for i, count := range t.Counts {
+		// scale the bars to at most 50 wide
+		bar := strings.Repeat("#", (count*50+largest-1)/largest)
+		fmt.Fprintf(w, "%3d: %5d %s\n", i+1, count, bar)
+	}
+	_, err := fmt.Fprintf(w, "Total: %d removed in %d generations\n\nFinal:\n%s\n", t.Total(), len(t.Counts), t.Final)
+	return err
+}
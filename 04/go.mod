@@ -1,3 +1,13 @@
 module advent-of-code-2025/04
 
 go 1.24.2
+
+require (
+	advent-of-code-2025/aoc v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/internal v0.0.0-00010101000000-000000000000
+)
+
+replace (
+	advent-of-code-2025/aoc => ../aoc
+	advent-of-code-2025/internal => ../internal
+)
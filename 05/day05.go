@@ -0,0 +1,10 @@
+package main
+
+import (
+	"advent-of-code-2025/05/day05"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	aoc.Main(5, day05.Solver{})
+}
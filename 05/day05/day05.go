@@ -1,11 +1,12 @@
-package main
+package day05
 
 import (
-	"fmt"
-	"os"
+	"bytes"
+	"io"
+	"slices"
 	"sort"
-	"strings"
-	"time"
+
+	"advent-of-code-2025/internal/parse"
 )
 
 // define a generic interval range type
@@ -47,28 +48,99 @@ func (node *IntIntervalTreeNode) contains(value int64) bool {
 	return false
 }
 
-func main() {
-	data, err := os.ReadFile("input.txt")
+type Solver struct{}
+
+func (Solver) Part1(input []byte) (any, error) {
+	inventory, err := Parse(bytes.NewReader(input))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	flattendRanges := flattenRanges(inventory.Ranges)
 
-	// split the input data into lines
-	parts := strings.Split(strings.TrimSpace(string(data)), "\n\n")
-	validRanges := strings.Split(parts[0], "\n")
-	ingredients := strings.Split(parts[1], "\n")
+	// Build the interval tree from the flattened ranges
+	intervalTree := buildBinaryIntervalTree(flattendRanges, 0, len(flattendRanges)-1)
 
-	startTime := time.Now()
+	// Check each ingredient against the interval tree
 	total := 0
+	for _, ingredient := range inventory.Ingredients {
+		if intervalTree.contains(ingredient) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (Solver) Part2(input []byte) (any, error) {
+	inventory, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+
+	// part 2: sum range differences
+	var totalValidIds int64
+	for _, r := range flattenRanges(inventory.Ranges) {
+		totalValidIds += r.max - r.min + 1
+	}
+	return totalValidIds, nil
+}
+
+// Input is the fresh ingredient ID ranges and the available ingredient IDs
+type Input struct {
+	Ranges      []IntInterval
+	Ingredients []int64
+}
+
+// Parse reads the fresh ID ranges, one per line such as 3-5, then a blank
+// line and the available ingredient IDs, one per line
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return Input{}, err
+	}
+	sections := parse.Split(lines)
+	switch {
+	case len(sections) < 2:
+		return Input{}, &parse.Error{Line: len(lines) + 1, Col: 1, Msg: "expected a blank line followed by ingredient IDs"}
+	case len(sections) > 2:
+		return Input{}, sections[2][0].Errorf("unexpected third section")
+	}
 
-	// Parse valid integer ranges into an array of Interval[int] to build the interval tree
 	// Ranges are separated by a dash '-'
-	var ranges []IntInterval
-	for _, line := range validRanges {
-		var min, max int64
-		fmt.Sscanf(line, "%d-%d", &min, &max)
-		ranges = append(ranges, IntInterval{min: min, max: max})
+	var in Input
+	for _, line := range sections[0] {
+		min, err := line.Int64()
+		if err != nil {
+			return Input{}, err
+		}
+		if err := line.Expect("-"); err != nil {
+			return Input{}, err
+		}
+		max, err := line.Int64()
+		if err != nil {
+			return Input{}, err
+		}
+		if err := line.End(); err != nil {
+			return Input{}, err
+		}
+		in.Ranges = append(in.Ranges, IntInterval{min: min, max: max})
+	}
+
+	for _, line := range sections[1] {
+		ingredient, err := line.Int64()
+		if err != nil {
+			return Input{}, err
+		}
+		if err := line.End(); err != nil {
+			return Input{}, err
+		}
+		in.Ingredients = append(in.Ingredients, ingredient)
 	}
+	return in, nil
+}
+
+// flattenRanges returns the ranges sorted and with overlapping ranges merged
+func flattenRanges(ranges []IntInterval) []IntInterval {
+	ranges = slices.Clone(ranges)
 
 	// sort ranges by min value ascending, then max value descending
 	sort.Slice(ranges, func(i, j int) bool {
@@ -95,26 +167,5 @@ func main() {
 		}
 	}
 
-	// Build the interval tree from the flattened ranges
-	intervalTree := buildBinaryIntervalTree(flattendRanges, 0, len(flattendRanges)-1)
-
-	// Check each ingredient against the interval tree
-	for _, line := range ingredients {
-		var ingredient int64
-		fmt.Sscanf(line, "%d", &ingredient)
-		if intervalTree.contains(ingredient) {
-			total++
-		}
-	}
-
-	// part 2: sum range differences
-	var totalValidIds int64
-	for _, r := range flattendRanges {
-		totalValidIds += r.max - r.min + 1
-	}
-
-	elapsed := time.Since(startTime)
-	fmt.Printf("Part 1 - valid ingredient IDs count: %d\n", total)
-	fmt.Printf("Part 2 - Total potentially valid ingredient IDs: %d\n", totalValidIds)
-	fmt.Printf("Execution time: %s\n", elapsed)
+	return flattendRanges
 }
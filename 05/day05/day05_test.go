@@ -0,0 +1,26 @@
+package day05
+
+import (
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{}, "../sample.txt", "3", "14"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"advent-of-code-2025/06/day06"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	aoc.Main(6, day06.Solver{})
+}
@@ -0,0 +1,137 @@
+package day06
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"advent-of-code-2025/aoc"
+	"advent-of-code-2025/internal/parse"
+)
+
+type Solver struct{}
+
+func (Solver) Part1(input []byte) (any, error) {
+	return nil, aoc.ErrUnsolved
+}
+
+// Input is the worksheet: rows of digits and spaces, all padded to the same
+// width, and the operator of each problem from left to right
+type Input struct {
+	Rows      []string
+	Operators []string
+}
+
+// Parse reads the rows of numbers followed by a last line of + and *
+// operators, one per problem
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return Input{}, err
+	}
+	if len(lines) < 2 {
+		return Input{}, &parse.Error{Line: len(lines) + 1, Col: 1, Msg: "expected rows of numbers followed by a row of operators"}
+	}
+
+	var in Input
+	width := 0
+	for _, line := range lines[:len(lines)-1] {
+		if col := strings.IndexFunc(line.Text, func(c rune) bool { return c != ' ' && (c < '0' || c > '9') }); col >= 0 {
+			return Input{}, line.ErrorAt(col, "expected digit or space")
+		}
+		width = max(width, len(line.Text))
+	}
+	// editors may trim trailing spaces, so pad every row back to full width
+	for _, line := range lines[:len(lines)-1] {
+		in.Rows = append(in.Rows, line.Text+strings.Repeat(" ", width-len(line.Text)))
+	}
+
+	operators := lines[len(lines)-1]
+	for !operators.Done() {
+		if operators.Accept(' ') {
+			continue
+		}
+		start := operators.Pos
+		operator := operators.Until(' ')
+		if operator != "+" && operator != "*" {
+			return Input{}, operators.ErrorAt(start, "expected + or *")
+		}
+		in.Operators = append(in.Operators, operator)
+	}
+	return in, nil
+}
+
+func (Solver) Part2(input []byte) (any, error) {
+	worksheet, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	lines := worksheet.Rows
+	// numbers are positive integers in vertical columns, read top-to-bottom right-to-left
+	// a column of only spaces is a separator between groups of numbers
+
+	total := int64(0)
+
+	numLines := len(lines)
+
+	// operators for each group of columns
+	operators := worksheet.Operators
+	numGroups := len(operators)
+
+	var totals []int64 = make([]int64, numGroups)
+
+	// process each line
+	currentGroup := 0
+	j := 0
+
+	numStr := make([]byte, numLines)
+	for currentGroup < numGroups {
+		for j < len(lines[0]) {
+			// reset numStr for each column
+			numStr = numStr[:0]
+			foundDigit := false
+
+			// collect digits per column to form numbers, then apply the operator
+			for i := 0; i < numLines; i++ {
+				digit := lines[i][j]
+				if digit != ' ' {
+					numStr = append(numStr, digit)
+				}
+			}
+
+			if len(numStr) > 0 {
+				foundDigit = true
+				num, err := strconv.ParseInt(string(numStr), 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				// fmt.Printf("Group %d: applying %s to %d\n", currentGroup, operators[currentGroup], num)
+
+				switch operators[currentGroup] {
+				case "+":
+					totals[currentGroup] += num
+				case "*":
+					if totals[currentGroup] == 0 {
+						totals[currentGroup] = 1
+					}
+					totals[currentGroup] *= num
+				}
+			}
+			j++
+
+			if !foundDigit {
+				break
+			}
+		}
+
+		currentGroup++
+	}
+
+	// sum up the totals
+	for _, colTotal := range totals {
+		total += colTotal
+	}
+
+	return total, nil
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"advent-of-code-2025/07/day07"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	aoc.Main(7, day07.Solver{})
+}
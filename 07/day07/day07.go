@@ -0,0 +1,83 @@
+package day07
+
+import (
+	"bytes"
+	"io"
+
+	"advent-of-code-2025/internal/grid"
+	"advent-of-code-2025/internal/mathx"
+	"advent-of-code-2025/internal/parse"
+)
+
+// Input is the tachyon manifold: '.' empty space, '^' a splitter, and the
+// beam start 'S' in the top row
+type Input = grid.Grid
+
+// Parse reads the manifold, one row per line
+func Parse(r io.Reader) (Input, error) {
+	g, err := grid.Parse(r, ".^S")
+	if err != nil {
+		return nil, err
+	}
+	if len(g) == 0 || bytes.IndexByte(g[0], 'S') < 0 {
+		return nil, &parse.Error{Line: 1, Col: 1, Msg: "no beam start `S` in the first line"}
+	}
+	return g, nil
+}
+
+type Solver struct{}
+
+func (Solver) Part1(input []byte) (any, error) {
+	manifold, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	totalSplits, _ := traceBeams(manifold)
+	return totalSplits, nil
+}
+
+func (Solver) Part2(input []byte) (any, error) {
+	manifold, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	_, tachyonBeams := traceBeams(manifold)
+	return mathx.Sum[int64](tachyonBeams), nil
+}
+
+// traceBeams follows the beams down the manifold, returning the number of splits
+// and the number of timelines ending in each column
+func traceBeams(lines Input) (int64, []int) {
+
+	totalSplits := int64(0)
+
+	// find the index of `S` in the first line
+	sIndex := bytes.IndexByte(lines[0], 'S')
+	// build a slice of bools indicating tachyon beams (T) in that column, and initalize to false
+	var tachyonBeams []int = make([]int, len(lines[0]))
+	tachyonBeams[sIndex] = 1
+
+	// process each line after the first one, updating tachyonBeams slice to carry the beams down,
+	// splitting around `^` characters
+	for _, line := range lines[1:] {
+		for j, char := range line {
+			currentBeams := tachyonBeams[j]
+			if currentBeams == 0 {
+				continue
+			}
+			if currentBeams > 0 && char == '^' {
+				// split the beam
+				if j > 0 {
+					tachyonBeams[j-1] += currentBeams
+				}
+				if j < len(line)-1 {
+					tachyonBeams[j+1] += currentBeams
+				}
+				tachyonBeams[j] = 0
+				totalSplits++
+			}
+		}
+	}
+
+	return totalSplits, tachyonBeams
+}
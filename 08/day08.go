@@ -0,0 +1,14 @@
+package main
+
+import (
+	"flag"
+
+	"advent-of-code-2025/08/day08"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	var solver day08.Solver
+	flag.IntVar(&solver.Connections, "connections", day08.DefaultConnections, "number of closest pairs to connect for part 1 (10 for the sample)")
+	aoc.Main(8, &solver)
+}
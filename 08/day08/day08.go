@@ -0,0 +1,137 @@
+package day08
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+type Point3D struct {
+	X int
+	Y int
+	Z int
+}
+
+func (point Point3D) StraightlineDistance(other Point3D) float64 {
+	dx := point.X - other.X
+	dy := point.Y - other.Y
+	dz := point.Z - other.Z
+	return math.Sqrt(float64(dx*dx + dy*dy + dz*dz))
+}
+
+type Edge struct {
+	A        int
+	B        int
+	Distance float64
+}
+
+// DefaultConnections is the number of closest pairs connected for part 1 of the real input
+const DefaultConnections = 1000
+
+type Solver struct {
+	// Connections is the number of closest pairs to connect in part 1 (DefaultConnections when zero)
+	Connections int
+}
+
+// Part1 multiplies together the sizes of the three largest circuits after connecting the closest pairs
+func (s Solver) Part1(input []byte) (any, error) {
+	limit := s.Connections
+	if limit == 0 {
+		limit = DefaultConnections
+	}
+
+	points, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	uf, _ := connect(points, sortedEdges(points), limit)
+
+	// Calculate product of three largest
+	sizes := uf.TopNSizes(3)
+	total := int64(1)
+	for _, size := range sizes {
+		total *= int64(size)
+	}
+	return total, nil
+}
+
+// Part2 multiplies the X coordinates of the last pair needed to join every box into one circuit
+func (Solver) Part2(input []byte) (any, error) {
+	points, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	if len(points) < 2 {
+		return nil, fmt.Errorf("%d junction boxes, need at least 2 to join", len(points))
+	}
+	_, lastEdge := connect(points, sortedEdges(points), math.MaxInt)
+	return points[lastEdge.A].X * points[lastEdge.B].X, nil
+}
+
+// Input is the junction box positions
+type Input []Point3D
+
+// Parse reads one X,Y,Z junction box position per line
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(Input, 0, len(lines))
+	for _, line := range lines {
+		coords, err := line.Ints(',')
+		if err != nil {
+			return nil, err
+		}
+		if len(coords) != 3 {
+			return nil, line.Errorf("expected 3 coordinates, found %d", len(coords))
+		}
+		if err := line.End(); err != nil {
+			return nil, err
+		}
+		points = append(points, Point3D{X: coords[0], Y: coords[1], Z: coords[2]})
+	}
+	return points, nil
+}
+
+func sortedEdges(points []Point3D) []Edge {
+	// Generate all edges
+	edges := make([]Edge, 0)
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			dist := points[i].StraightlineDistance(points[j])
+			edges = append(edges, Edge{A: i, B: j, Distance: dist})
+		}
+	}
+
+	// Sort edges by distance
+	slices.SortFunc(edges, func(a, b Edge) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	return edges
+}
+
+// connect processes up to limit edges, returning the resulting groups and the last edge that joined two of them
+func connect(points []Point3D, edges []Edge, limit int) (*UnionFind, Edge) {
+	// Build rooted "graphs" using Union-Find algorithm
+	uf := NewUnionFind(len(points))
+	lastEdge := Edge{}
+	for i, edge := range edges {
+		// fmt.Printf("Processing edge: %+v\n", edge)
+		if i >= limit {
+			break
+		}
+		if !uf.Connected(edge.A, edge.B) {
+			// fmt.Printf("  Connecting points %d and %d\n", edge.A, edge.B)
+			uf.Union(edge.A, edge.B)
+			lastEdge = edge
+		}
+	}
+	return uf, lastEdge
+}
@@ -0,0 +1,34 @@
+package day08
+
+import (
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{Connections: 10}, "../sample.txt", "40", "25272"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestPart2TooFewBoxes(t *testing.T) {
+	for _, input := range []string{"", "1,2,3\n"} {
+		if got, err := (Solver{}).Part2([]byte(input)); err == nil {
+			t.Errorf("Part2(%q) = %v, want an error", input, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"advent-of-code-2025/09/day09"
+	"advent-of-code-2025/aoc"
+)
+
+func main() {
+	aoc.Main(9, day09.Solver{})
+}
@@ -1,51 +1,42 @@
-package main
+package day09
 
 import (
-	"fmt"
-	"os"
-	"strconv"
-	"strings"
-	"time"
+	"bytes"
+	"io"
+
+	"advent-of-code-2025/internal/mathx"
+	"advent-of-code-2025/internal/parse"
 )
 
-func main() {
-	data, err := os.ReadFile("input.txt")
+type Solver struct{}
+
+func (Solver) Part1(input []byte) (any, error) {
+	points, err := Parse(bytes.NewReader(input))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	// split the input data into lines
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
 
-	startTime := time.Now()
 	maxArea := int64(0)
-
-	points := make([][2]int, 0, len(lines))
-	for _, line := range lines {
-		coords := strings.Split(line, ",")
-		if len(coords) != 2 {
-			panic("invalid coordinate line: " + line)
-		}
-		x, err1 := strconv.Atoi(coords[0])
-		y, err2 := strconv.Atoi(coords[1])
-		if err1 != nil || err2 != nil {
-			panic("invalid coordinate values: " + line)
-		}
-		points = append(points, [2]int{x, y})
-	}
-
 	// Part 1: Find the largest area of a rectangle defined by any two points
 	for i := 0; i < len(points); i++ {
 		area := int64(0)
 		for j := i + 1; j < len(points); j++ {
-			dx := int64(abs(points[j][0]-points[i][0])) + 1
-			dy := int64(abs(points[j][1]-points[i][1])) + 1
+			dx := int64(mathx.Abs(points[j][0]-points[i][0])) + 1
+			dy := int64(mathx.Abs(points[j][1]-points[i][1])) + 1
 			area = dx * dy
 			if area > maxArea {
 				maxArea = area
 			}
 		}
 	}
-	fmt.Printf("Part 1 - max area of a rectangle (ignoring perimeter): %d\n", maxArea)
+	return maxArea, nil
+}
+
+func (Solver) Part2(input []byte) (any, error) {
+	points, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
 
 	// sortedPoints := make([][2]int, len(points))
 	// copy(sortedPoints, points)
@@ -56,10 +47,10 @@ func main() {
 	// 	}
 	// 	return a[0] - b[0]
 	// })
+	maxArea := int64(0)
 	polygon := Polygon{Points: points}
 
 	// Part 2: Find the largest area of a rectangle contained within perimeter points
-	maxArea = 0
 	for i := 0; i < len(points); i++ {
 		area := int64(0)
 		for j := i + 1; j < len(points); j++ {
@@ -110,28 +101,32 @@ func main() {
 			}
 		}
 	}
-	fmt.Printf("Part 2 - max area of a rectangle (on/within perimeter): %d\n", maxArea)
-
-	fmt.Printf("Execution time: %s\n", time.Since(startTime))
+	return maxArea, nil
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
+// Input is the red tile positions, in order around the loop
+type Input [][2]int
 
-func max(a, b int) int {
-	if a > b {
-		return a
+// Parse reads one X,Y red tile position per line
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
 	}
-	return b
-}
 
-func abs(a int) int {
-	if a < 0 {
-		return -a
+	points := make(Input, 0, len(lines))
+	for _, line := range lines {
+		coords, err := line.Ints(',')
+		if err != nil {
+			return nil, err
+		}
+		if len(coords) != 2 {
+			return nil, line.Errorf("expected 2 coordinates, found %d", len(coords))
+		}
+		if err := line.End(); err != nil {
+			return nil, err
+		}
+		points = append(points, [2]int{coords[0], coords[1]})
 	}
-	return a
+	return points, nil
 }
@@ -1,4 +1,4 @@
-package main
+package day09
 
 // assume that the polygon is simple (no self-intersections) and right-handed (points ordered clockwise)
 type Polygon struct {
@@ -0,0 +1,33 @@
+package day09
+
+import "testing"
+
+func TestPolygonContains(t *testing.T) {
+	// the red tiles from the sample, walked in order
+	polygon := Polygon{Points: [][2]int{{7, 1}, {11, 1}, {11, 7}, {9, 7}, {9, 5}, {2, 5}, {2, 3}, {7, 3}}}
+
+	tests := []struct {
+		name  string
+		point [2]int
+		want  bool
+	}{
+		{"vertex", [2]int{7, 1}, true},
+		{"horizontal edge", [2]int{9, 1}, true},
+		{"vertical edge", [2]int{2, 4}, true},
+		{"reflex vertex", [2]int{9, 5}, true},
+		{"inside top", [2]int{8, 2}, true},
+		{"inside middle", [2]int{3, 4}, true},
+		{"inside bottom", [2]int{10, 6}, true},
+		{"outside notch", [2]int{3, 2}, false},
+		{"outside bottom", [2]int{5, 6}, false},
+		{"outside left", [2]int{0, 4}, false},
+		{"outside right", [2]int{12, 4}, false},
+		{"outside above", [2]int{9, 0}, false},
+	}
+
+	for _, tt := range tests {
+		if got := polygon.Contains(tt.point); got != tt.want {
+			t.Errorf("%s: Contains(%v) = %t, want %t", tt.name, tt.point, got, tt.want)
+		}
+	}
+}
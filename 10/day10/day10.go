@@ -0,0 +1,248 @@
+package day10
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"advent-of-code-2025/internal/parse"
+	"advent-of-code-2025/internal/timing"
+)
+
+type Problem struct {
+	lineNum               int
+	buttons               []int
+	desiredIndicatorState string
+	joltageTarget         []int
+}
+
+// JoltageSolver selects the algorithm used for the joltage targets in part 2
+type JoltageSolver string
+
+const (
+	Partition JoltageSolver = "partition" // partition-based DFS with aggressive button removal
+	MILP      JoltageSolver = "milp"      // simplex + branch-and-bound
+	CSP       JoltageSolver = "csp"       // constraint propagation + DFS
+)
+
+type Solver struct {
+	// Joltage is the part 2 algorithm (Partition when empty)
+	Joltage JoltageSolver
+}
+
+// Algorithm describes the joltage solver used for part 2
+func (s Solver) Algorithm() string {
+	switch s.Joltage {
+	case MILP:
+		return "MILP solver (simplex + branch-and-bound)"
+	case CSP:
+		return "CSP solver (constraint propagation + DFS)"
+	default:
+		return "Partition solver (aggressive button removal)"
+	}
+}
+
+// Part1 totals the minimum button presses needed to reach each indicator light pattern
+func (Solver) Part1(input []byte) (any, error) {
+	problems, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+
+	presses := solveAll(problems, func(p Problem) int {
+		return FindMinButtonPressesForIndicatorTarget(p.buttons, p.desiredIndicatorState)
+	})
+
+	totalPresses := int64(0)
+	for _, n := range presses {
+		totalPresses += int64(n)
+	}
+	return totalPresses, nil
+}
+
+// Part2 totals the minimum button presses needed to reach each joltage target
+func (s Solver) Part2(input []byte) (any, error) {
+	problems, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+
+	var findMinButtonPresses func(buttons []int, joltageTargets []int) int
+	switch s.Joltage {
+	case MILP:
+		findMinButtonPresses = FindMinButtonPressesMILP
+	case CSP:
+		findMinButtonPresses = FindMinButtonPresses
+	case Partition, "":
+		findMinButtonPresses = FindMinButtonPressesPartition
+	default:
+		return nil, fmt.Errorf("unknown joltage solver: %s", s.Joltage)
+	}
+
+	presses := solveAll(problems, func(p Problem) int {
+		return findMinButtonPresses(p.buttons, p.joltageTarget)
+	})
+
+	totalJoltagePresses := int64(0)
+	impossibleCount := 0
+	for lineNum, n := range presses {
+		// Check for math.MaxInt (impossible case) to avoid overflow
+		if n == math.MaxInt {
+			impossibleCount++
+			fmt.Fprintf(os.Stderr, "Warning: Problem %d returned impossible (math.MaxInt)\n", lineNum+1)
+		} else {
+			totalJoltagePresses += int64(n)
+		}
+	}
+
+	if impossibleCount > 0 {
+		fmt.Fprintf(os.Stderr, "WARNING: %d problems were impossible to solve!\n", impossibleCount)
+	}
+	return totalJoltagePresses, nil
+}
+
+// Input is the list of machines, one problem each
+type Input []Problem
+
+// Parse reads one machine per line
+// lines are in the format "[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}"
+// we need to parse the square brackets at the front for the desired state of the indicator lights (zero indexed from left to right)
+// the curly braces at the end are the joltage targets (part 2 only)
+// in between are the button definitions, each in parentheses, indicating which indicator lights each button toggles
+// initial state of all indicator lights is off (represented by '.')
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make(Input, len(lines))
+	for n, line := range lines {
+		if err := line.Expect("["); err != nil {
+			return nil, err
+		}
+		start := line.Pos
+		desiredIndicatorState := line.Until(']')
+		if i := strings.IndexFunc(desiredIndicatorState, func(c rune) bool { return c != '.' && c != '#' }); i >= 0 {
+			return nil, line.ErrorAt(start+i, "expected . or #")
+		}
+		if err := line.Expect("]"); err != nil {
+			return nil, err
+		}
+		numSlots := len(desiredIndicatorState)
+
+		var buttons []int
+		for line.Accept(' ') && line.Accept('(') {
+			button := 0
+			start := line.Pos
+			indices, err := line.Ints(',')
+			if err != nil {
+				return nil, err
+			}
+			for _, idx := range indices {
+				if idx < 0 || idx >= numSlots {
+					return nil, line.ErrorAt(start, "button wires light %d, but there are only %d lights", idx, numSlots)
+				}
+				button |= (1 << idx)
+			}
+			if err := line.Expect(")"); err != nil {
+				return nil, err
+			}
+			buttons = append(buttons, button)
+		}
+
+		if err := line.Expect("{"); err != nil {
+			return nil, err
+		}
+		start = line.Pos
+		joltageTarget, err := line.Ints(',')
+		if err != nil {
+			return nil, err
+		}
+		if len(joltageTarget) != numSlots {
+			return nil, line.ErrorAt(start, "%d joltage targets for %d indicator lights", len(joltageTarget), numSlots)
+		}
+		if err := line.Expect("}"); err != nil {
+			return nil, err
+		}
+		if err := line.End(); err != nil {
+			return nil, err
+		}
+
+		problems[n] = Problem{
+			lineNum:               n,
+			buttons:               buttons,
+			desiredIndicatorState: desiredIndicatorState,
+			joltageTarget:         joltageTarget,
+		}
+	}
+	return problems, nil
+}
+
+// solveAll runs solve over every problem on a pool of workers, returning the results in problem order
+func solveAll(problems []Problem, solve func(Problem) int) []int {
+	// Level 1 parallelization: process problems concurrently
+	numWorkers := runtime.NumCPU()
+
+	results := make([]int, len(problems))
+	var wg sync.WaitGroup
+	problemChan := make(chan int, len(problems))
+
+	var completed int64
+
+	// Track in-progress problems for visibility
+	inProgress := make([]int64, numWorkers)
+
+	// Start workers
+	for w := 0; w < numWorkers; w++ {
+		workerID := w
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range problemChan {
+				p := problems[idx]
+				atomic.StoreInt64(&inProgress[workerID], int64(p.lineNum+1))
+				// label the work so profiles can be broken down by problem
+				duration := timing.Measure(func() {
+					pprof.Do(context.Background(), pprof.Labels("problem", strconv.Itoa(p.lineNum+1)), func(context.Context) {
+						results[idx] = solve(p)
+					})
+				})
+
+				atomic.StoreInt64(&inProgress[workerID], 0)
+				done := atomic.AddInt64(&completed, 1)
+
+				// Show which problems are still in progress
+				stillWorking := []int64{}
+				for i := 0; i < numWorkers; i++ {
+					if prob := atomic.LoadInt64(&inProgress[i]); prob > 0 {
+						stillWorking = append(stillWorking, prob)
+					}
+				}
+				if duration > 2*time.Second {
+					fmt.Fprintf(os.Stderr, "Completed %d/%d (problem %d) in %s | Still working: %v\n",
+						done, len(problems), p.lineNum+1, duration, stillWorking)
+				}
+			}
+		}()
+	}
+
+	// Send work
+	for i := range problems {
+		problemChan <- i
+	}
+	close(problemChan)
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,70 @@
+package day10
+
+import (
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample partition", Solver{Joltage: Partition}, "../sample.txt", "7", "33"},
+		{"sample csp", Solver{Joltage: CSP}, "../sample.txt", "7", "33"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func BenchmarkIndicator(b *testing.B) {
+	input := aoctest.ReadBenchInput(b, "..")
+	for b.Loop() {
+		Solver{}.Part1(input)
+	}
+}
+
+func BenchmarkJoltage(b *testing.B) {
+	input := aoctest.ReadBenchInput(b, "..")
+	for _, joltage := range []JoltageSolver{Partition, CSP, MILP} {
+		b.Run(string(joltage), func(b *testing.B) {
+			solver := Solver{Joltage: joltage}
+			for b.Loop() {
+				solver.Part2(input)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"[.##.] (3) (1,3) {3,5,4,7}\n", ""},
+		{"[.#x.] (3) {3,5,4,7}\n", "1:4: expected . or #"},
+		{"[.##.] (3) (1;3) {3,5,4,7}\n", `1:14: expected ")"`},
+		{"[.##.] (4) {3,5,4,7}\n", "1:9: button wires light 4, but there are only 4 lights"},
+		{"[.##.] (3) {3,5,4}\n", "1:13: 3 joltage targets for 4 indicator lights"},
+		{"[.##.] (3)\n", `1:11: expected "{"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if tt.err == "" && err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+		} else if tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package day10
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNextPartition(t *testing.T) {
+	tests := []struct {
+		total, parts int
+		want         int // number of ways to split total across parts
+	}{
+		{0, 1, 1},
+		{5, 1, 1},
+		{3, 2, 4},
+		{3, 3, 10},
+		{4, 3, 15},
+		{2, 4, 10},
+	}
+
+	for _, tt := range tests {
+		partition := make([]int, tt.parts)
+		partition[tt.parts-1] = tt.total
+
+		seen := make(map[string]bool)
+		for {
+			sum := 0
+			for _, v := range partition {
+				if v < 0 {
+					t.Fatalf("partition of %d into %d has negative part: %v", tt.total, tt.parts, partition)
+				}
+				sum += v
+			}
+			if sum != tt.total {
+				t.Fatalf("partition of %d into %d sums to %d: %v", tt.total, tt.parts, sum, partition)
+			}
+			key := fmt.Sprint(partition)
+			if seen[key] {
+				t.Fatalf("partition of %d into %d repeated: %v", tt.total, tt.parts, partition)
+			}
+			seen[key] = true
+
+			if !nextPartition(partition) {
+				break
+			}
+		}
+
+		if len(seen) != tt.want {
+			t.Errorf("partitions of %d into %d = %d, want %d", tt.total, tt.parts, len(seen), tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package day11
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+type Solver struct{}
+
+// Part1 counts every path from `you` to `out`
+func (Solver) Part1(input []byte) (any, error) {
+	graph, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	cache := make(map[string]int)
+	return countPaths(graph, "you", "out", make(map[string]bool), true, true, cache), nil
+}
+
+// Part2 counts the paths from `svr` to `out` that also pass through `dac` and `fft` (in any order)
+func (Solver) Part2(input []byte) (any, error) {
+	graph, err := Parse(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	cache := make(map[string]int)
+	return countPaths(graph, "svr", "out", make(map[string]bool), false, false, cache), nil
+}
+
+// line format: `sdf: you bla out`
+// before the colon is the machine/node name; afterwards are the machin/node names that output flows to
+// one of the machine names is `you`, representing the machines that you have direct access to
+// map paths from `you` to `out`.
+// `out` is a termination point.
+
+// considerations:
+//	1. Are there any circular paths?
+
+// Input is the device graph: each device's list of output devices
+type Input map[string][]string
+
+// Parse reads one device per line, such as "aaa: you hhh"
+func Parse(r io.Reader) (Input, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate a graph of the input... map[string][]string?
+	// parse lines into a map:
+	graph := make(Input, len(lines))
+	for _, line := range lines {
+		node := line.Until(':')
+		if node == "" {
+			return nil, line.Errorf("expected device name")
+		}
+		if _, ok := graph[node]; ok {
+			return nil, line.ErrorAt(0, "device %s listed twice", node)
+		}
+		if err := line.Expect(": "); err != nil {
+			return nil, err
+		}
+		var connections []string
+		for !line.Done() {
+			output := line.Until(' ')
+			if output == "" {
+				return nil, line.Errorf("expected device name")
+			}
+			connections = append(connections, output)
+			line.Accept(' ')
+		}
+		graph[node] = connections
+	}
+	return graph, nil
+}
+
+func countPaths(graph map[string][]string, startNode string, endNode string, visited map[string]bool, foundDac bool, foundFft bool, cache map[string]int) int {
+	if startNode == endNode {
+		if foundDac && foundFft {
+			return 1
+		}
+		return 0
+	}
+
+	// Update flags for required nodes
+	if startNode == "dac" {
+		foundDac = true
+	}
+	if startNode == "fft" {
+		foundFft = true
+	}
+
+	// Check cache - key is node + state of required flags
+	cacheKey := fmt.Sprintf("%s:%t:%t", startNode, foundDac, foundFft)
+	if cachedCount, ok := cache[cacheKey]; ok {
+		return cachedCount
+	}
+
+	linkedNodes, ok := graph[startNode]
+	if !ok {
+		return 0
+	}
+
+	visited[startNode] = true
+
+	pathCount := 0
+	for _, nextNode := range linkedNodes {
+		if visited[nextNode] {
+			continue
+		}
+		pathCount += countPaths(graph, nextNode, endNode, visited, foundDac, foundFft, cache)
+	}
+
+	delete(visited, startNode)
+
+	cache[cacheKey] = pathCount
+	return pathCount
+}
@@ -0,0 +1,57 @@
+package day12
+
+import (
+	"strings"
+	"testing"
+
+	"advent-of-code-2025/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	tests := []struct {
+		name         string
+		solver       Solver
+		input        string
+		part1, part2 string
+	}{
+		{"sample", Solver{}, "../sample.txt", "2", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := aoctest.ReadInput(t, tt.input)
+			aoctest.CheckPart(t, "Part1", tt.solver.Part1, input, tt.part1)
+			aoctest.CheckPart(t, "Part2", tt.solver.Part2, input, tt.part2)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"0:\n##\n\n4x4: 1\n", ""},
+		{"0:\n##\n\n4x4: 1 1\n", "4:6: 2 present counts for 1 shapes"},
+		{"0:\n\n4x4: 1\n", "2:1: expected . or #"},
+		{"0:\n##\n#\n\n4x4: 1\n", "3:1: shape row of 1 cells, but the first has 2"},
+		{"0:\n#x\n\n4x4: 1\n", "2:2: expected . or #"},
+		{"1:\n##\n\n4x4: 1\n", "1:1: expected shape 0"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if tt.err == "" && err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+		} else if tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.err)
+		}
+	}
+}
+
+func BenchmarkBitsetMRV(b *testing.B) {
+	input := aoctest.ReadBenchInput(b, "..")
+	for b.Loop() {
+		Solver{}.Part1(input)
+	}
+}
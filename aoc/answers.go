@@ -0,0 +1,75 @@
+package aoc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AnswersFile holds the recorded answers for the inputs in a day's directory.
+// Each line names an input file and its two answers, with - for a part that
+// has no recorded answer:
+//
+//	input.txt: 1150 6738
+//	sample.txt: 3 6
+const AnswersFile = "answers.txt"
+
+// Answers maps an input file name to its recorded part 1 and part 2 answers.
+type Answers map[string][2]string
+
+// ParseAnswers reads answers in the AnswersFile format. Blank lines and lines
+// starting with # are ignored.
+func ParseAnswers(r io.Reader) (Answers, error) {
+	answers := make(Answers)
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, values, found := strings.Cut(line, ":")
+		fields := strings.Fields(values)
+		if !found || len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("line %d: expected \"<input file>: <part1> <part2>\"", lineNum)
+		}
+
+		var expected [2]string
+		for i, field := range fields {
+			if field != "-" {
+				expected[i] = field
+			}
+		}
+		answers[strings.TrimSpace(name)] = expected
+	}
+	return answers, scanner.Err()
+}
+
+// LoadAnswers finds the recorded answers for the input at path in the
+// AnswersFile next to it. It reports false when nothing is recorded.
+func LoadAnswers(path string) ([2]string, bool, error) {
+	if path == Stdin {
+		return [2]string{}, false, nil
+	}
+
+	answersPath := filepath.Join(filepath.Dir(path), AnswersFile)
+	f, err := os.Open(answersPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return [2]string{}, false, nil
+	} else if err != nil {
+		return [2]string{}, false, err
+	}
+	defer f.Close()
+
+	answers, err := ParseAnswers(f)
+	if err != nil {
+		return [2]string{}, false, fmt.Errorf("%s: %w", answersPath, err)
+	}
+	expected, ok := answers[filepath.Base(path)]
+	return expected, ok, nil
+}
@@ -0,0 +1,153 @@
+package aoc
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"advent-of-code-2025/aoc/profile"
+	"advent-of-code-2025/internal/parse"
+)
+
+// ErrUnsolved is returned by a Solver for a part it does not compute.
+var ErrUnsolved = errors.New("part not solved")
+
+// Solver is implemented by every day's solution package so that the per-day
+// binaries and the multi-day runner can drive them the same way.
+type Solver interface {
+	Part1(input []byte) (any, error)
+	Part2(input []byte) (any, error)
+}
+
+// AlgorithmNamer is implemented by solvers that can be configured to use one
+// of several algorithms, so the choice is reported alongside the answers.
+type AlgorithmNamer interface {
+	Algorithm() string
+}
+
+// VariantSolver is implemented by solvers that can be configured to solve a
+// variant of the puzzle, whose answers the AnswersFile doesn't record.
+type VariantSolver interface {
+	// Variant reports whether the solver is set to something other than the
+	// puzzle's own rules
+	Variant() bool
+}
+
+var (
+	// input is where Main reads the puzzle input from, set by the shared flags.
+	input Input
+	// format is how Main writes the result, set by the shared flags.
+	format = FormatText
+	// profiling is what Main profiles while the solver runs, set by the shared flags.
+	profiling profile.Config
+)
+
+// ParseFlags registers the flags shared by every day on the command line and
+// parses it. Main calls it, so a day only needs to call it first when it uses
+// its own flags before handing over to Main.
+func ParseFlags() {
+	if flag.Parsed() {
+		return
+	}
+	input.RegisterFlags(flag.CommandLine)
+	flag.Var(&format, "format", "output `format`: text or json")
+	profiling.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+	if flag.NArg() > 0 {
+		input.Path = flag.Arg(0)
+	}
+}
+
+// Main is the body of every day's main function: it parses the command line,
+// reads the selected input, runs both parts and prints the answers, checking
+// them against the AnswersFile when one records answers for the input and
+// the solver isn't set to a variant of the puzzle. It exits non-zero when a
+// part fails or does not match. Flags the day binds to
+// its solver must be defined before Main is called.
+func Main(day int, s Solver) {
+	profiled(day, func() int { return run(day, s) })
+}
+
+// Mode runs one of a day's extra modes in place of Main: it parses the
+// command line, reads the selected input and hands it to mode, which writes
+// its own output. Errors are printed like Main's, with parse errors placed
+// in the input file, and exit non-zero.
+func Mode(day int, mode func(input []byte) error) {
+	profiled(day, func() int {
+		data, err := input.Load(".")
+		if err == nil {
+			err = mode(data)
+		}
+		if err != nil {
+			reportError(day, err)
+			return 1
+		}
+		return 0
+	})
+}
+
+// profiled parses the command line and calls run with profiling started,
+// exiting with run's code once profiling has stopped
+func profiled(day int, run func() int) {
+	ParseFlags()
+
+	stop, err := profiling.Start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+		os.Exit(1)
+	}
+	code := run()
+	if err := stop(); err != nil {
+		fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+		code = 1
+	}
+	if code != 0 {
+		os.Exit(code)
+	}
+}
+
+// reportError prints err to stderr, reporting bad input like a compiler
+// would, as file:line:col: message
+func reportError(day int, err error) {
+	var parseErr *parse.Error
+	if errors.As(err, &parseErr) {
+		fmt.Fprintf(os.Stderr, "%s:%v\n", input.Source("."), parseErr)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+}
+
+// run does the work of Main, returning the exit code so that profiling can
+// be stopped before exiting
+func run(day int, s Solver) int {
+	path := input.Resolve(".")
+	data, err := input.Load(".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+		return 1
+	}
+	expected, verify, err := LoadAnswers(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+		return 1
+	}
+
+	result := Run(day, s, data)
+	if err := result.ParseError(); err != nil {
+		// report bad input on its own, rather than as a part failure
+		reportError(day, err)
+		return 1
+	}
+	if variant, ok := s.(VariantSolver); verify && !(ok && variant.Variant()) {
+		result.Verify(expected)
+	}
+	if err := result.Write(os.Stdout, format); err != nil {
+		fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+		return 1
+	}
+	if result.Failed() {
+		return 1
+	}
+	return 0
+}
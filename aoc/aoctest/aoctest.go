@@ -0,0 +1,55 @@
+// Package aoctest provides helpers for testing a day's Solver against its
+// example inputs.
+package aoctest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"advent-of-code-2025/aoc"
+)
+
+// ReadInput reads a test input file, failing the test if it cannot.
+func ReadInput(t testing.TB, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// CheckPart runs one part of a solver against the input and compares its
+// answer with want. An empty want leaves the part unchecked.
+func CheckPart(t *testing.T, name string, part func([]byte) (any, error), input []byte, want string) {
+	t.Helper()
+	if want == "" {
+		return
+	}
+	got, err := part(input)
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	if fmt.Sprint(got) != want {
+		t.Errorf("%s = %v, want %s", name, got, want)
+	}
+}
+
+// ReadBenchInput reads the puzzle input from dir for benchmarking, falling
+// back to the sample input when the puzzle input is not present.
+func ReadBenchInput(b *testing.B, dir string) []byte {
+	b.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, aoc.DefaultInput))
+	if errors.Is(err, fs.ErrNotExist) {
+		data, err = os.ReadFile(filepath.Join(dir, aoc.SampleInput))
+	}
+	if err != nil {
+		b.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,133 @@
+// Package fetch downloads puzzle inputs from the Advent of Code website and
+// caches them in each day's directory.
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// Year is the event the inputs are downloaded for.
+	Year = 2025
+
+	// SessionEnv is the environment variable holding the session cookie.
+	SessionEnv = "AOC_SESSION"
+	// BaseURLEnv is the environment variable that overrides DefaultBaseURL.
+	BaseURLEnv = "AOC_BASE_URL"
+
+	userAgent = "github.com/joelsearcy/advent-of-code-2025 input fetcher"
+)
+
+// ErrCached is returned by Download when the input is already on disk.
+var ErrCached = errors.New("input already cached")
+
+// Client downloads puzzle inputs for a logged-in session.
+type Client struct {
+	BaseURL    string       // DefaultBaseURL when empty
+	Session    string       // value of the "session" cookie
+	HTTPClient *http.Client // http.DefaultClient when nil
+}
+
+// Input downloads the puzzle input for day.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	url := fmt.Sprintf("%s/%d/day/%d/input", strings.TrimSuffix(baseURL, "/"), Year, day)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// Download fetches the input for day into path. It refuses, returning
+// ErrCached, when a non-empty file is already there.
+func (c *Client) Download(ctx context.Context, day int, path string) error {
+	if Cached(path) {
+		return ErrCached
+	}
+
+	data, err := c.Input(ctx, day)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so an interrupted download is never mistaken for a cached input
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Cached reports whether a non-empty input is already stored at path.
+func Cached(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Size() > 0
+}
+
+// SessionFile is the config file holding the session cookie, relative to
+// the user's config directory.
+var SessionFile = filepath.Join("aoc", "session")
+
+// LoadSession returns the session cookie from SessionEnv, or failing that
+// from SessionFile in the user's config directory.
+func LoadSession() (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnv)); session != "" {
+		return session, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(configDir, SessionFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("no session cookie: set %s or write it to %s", SessionEnv, path)
+	} else if err != nil {
+		return "", err
+	}
+
+	session := strings.TrimSpace(string(data))
+	if session == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return session, nil
+}
@@ -0,0 +1,132 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newServer stands in for the Advent of Code website, serving an input for
+// any day to requests carrying the "secret" session
+func newServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/2025/day/5/input" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("3-5\n\n4\n"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDownload(t *testing.T) {
+	requests := 0
+	server := newServer(t, &requests)
+	client := &Client{BaseURL: server.URL, Session: "secret"}
+	path := filepath.Join(t.TempDir(), "input.txt")
+
+	if err := client.Download(context.Background(), 5, path); err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "3-5\n\n4\n" {
+		t.Errorf("input = %q", data)
+	}
+
+	if err := client.Download(context.Background(), 5, path); !errors.Is(err, ErrCached) {
+		t.Errorf("second Download = %v, want ErrCached", err)
+	}
+	if requests != 1 {
+		t.Errorf("server saw %d requests, want 1", requests)
+	}
+}
+
+func TestDownloadReplacesEmptyFile(t *testing.T) {
+	requests := 0
+	server := newServer(t, &requests)
+	client := &Client{BaseURL: server.URL, Session: "secret"}
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Download(context.Background(), 5, path); err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("server saw %d requests, want 1", requests)
+	}
+}
+
+func TestDownloadErrors(t *testing.T) {
+	requests := 0
+	server := newServer(t, &requests)
+
+	tests := []struct {
+		name    string
+		session string
+		day     int
+	}{
+		{"bad session", "wrong", 5},
+		{"missing day", "secret", 13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{BaseURL: server.URL, Session: tt.session}
+			path := filepath.Join(t.TempDir(), "input.txt")
+			if err := client.Download(context.Background(), tt.day, path); err == nil {
+				t.Fatal("Download succeeded, want error")
+			}
+			if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("input file left behind after failed download: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+	t.Setenv(SessionEnv, "")
+
+	if _, err := LoadSession(); err == nil {
+		t.Error("LoadSession with no config succeeded, want error")
+	}
+
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		t.Skip(err)
+	}
+	path := filepath.Join(userConfigDir, SessionFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if session, err := LoadSession(); err != nil || session != "from-file" {
+		t.Errorf("LoadSession = %q, %v, want from-file", session, err)
+	}
+
+	t.Setenv(SessionEnv, "from-env")
+	if session, err := LoadSession(); err != nil || session != "from-env" {
+		t.Errorf("LoadSession = %q, %v, want from-env", session, err)
+	}
+}
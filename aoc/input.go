@@ -0,0 +1,78 @@
+package aoc
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultInput is the puzzle input file read from a day's directory
+	DefaultInput = "input.txt"
+	// SampleInput is the example input file read when -sample is given
+	SampleInput = "sample.txt"
+	// Stdin is the input path that reads from standard input
+	Stdin = "-"
+)
+
+// Input selects where a day's puzzle input is read from.
+type Input struct {
+	Path   string // file to read, or Stdin
+	Sample bool   // read SampleInput instead of Path
+}
+
+// RegisterFlags adds the -input and -sample flags to fs.
+func (in *Input) RegisterFlags(fs *flag.FlagSet) {
+	if in.Path == "" {
+		in.Path = DefaultInput
+	}
+	fs.StringVar(&in.Path, "input", in.Path, "puzzle input `file`, or - for standard input")
+	fs.BoolVar(&in.Sample, "sample", in.Sample, "read "+SampleInput+" instead of the puzzle input")
+}
+
+// Name is the path the input will be read from, relative to the day's directory.
+func (in Input) Name() string {
+	if in.Sample {
+		return SampleInput
+	}
+	if in.Path == "" {
+		return DefaultInput
+	}
+	return in.Path
+}
+
+// Resolve returns the path of the input file, with relative paths resolved
+// against dir, or Stdin.
+func (in Input) Resolve(dir string) string {
+	name := in.Name()
+	if name == Stdin || filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(dir, name)
+}
+
+// Source names the input in diagnostics: "<stdin>" for standard input,
+// otherwise its path, relative to the working directory where possible.
+func (in Input) Source(dir string) string {
+	path := in.Resolve(dir)
+	if path == Stdin {
+		return "<stdin>"
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return rel
+		}
+	}
+	return path
+}
+
+// Load reads the input. Relative paths are resolved against dir.
+func (in Input) Load(dir string) ([]byte, error) {
+	path := in.Resolve(dir)
+	if path == Stdin {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,158 @@
+// Package profile wires runtime/pprof and runtime/trace up to command-line
+// flags so every day can be profiled the same way.
+package profile
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	rpprof "runtime/pprof"
+	"runtime/trace"
+)
+
+// Config names the profiles to capture. Empty fields are disabled.
+type Config struct {
+	CPU   string // CPU profile file
+	Heap  string // heap profile file, written when profiling stops
+	Block string // goroutine blocking profile file, written when profiling stops
+	Mutex string // mutex contention profile file, written when profiling stops
+	Trace string // execution trace file
+	HTTP  string // address of a live /debug/pprof/ listener, on localhost unless a host is given
+}
+
+// RegisterFlags adds a flag for each profile to fs.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.CPU, "cpuprofile", "", "write cpu profile to `file`")
+	fs.StringVar(&c.Heap, "memprofile", "", "write memory profile to `file`")
+	fs.StringVar(&c.Block, "blockprofile", "", "write goroutine blocking profile to `file`")
+	fs.StringVar(&c.Mutex, "mutexprofile", "", "write mutex contention profile to `file`")
+	fs.StringVar(&c.Trace, "exectrace", "", "write execution trace to `file`")
+	fs.StringVar(&c.HTTP, "pprof-http", "", "serve live profiles on `addr` (e.g. :6060) under /debug/pprof/")
+}
+
+// Start begins capturing the configured profiles. The returned stop function
+// finishes them and writes the snapshot profiles; call it before exiting.
+func (c Config) Start() (stop func() error, err error) {
+	var stops []func() error
+	stopAll := func() error {
+		var errs []error
+		for i := len(stops) - 1; i >= 0; i-- {
+			errs = append(errs, stops[i]())
+		}
+		return errors.Join(errs...)
+	}
+	defer func() {
+		if err != nil {
+			stopAll()
+		}
+	}()
+
+	if c.HTTP != "" {
+		closeServer, err := serve(c.HTTP)
+		if err != nil {
+			return nil, err
+		}
+		stops = append(stops, closeServer)
+	}
+
+	if c.CPU != "" {
+		f, err := os.Create(c.CPU)
+		if err != nil {
+			return nil, err
+		}
+		if err := rpprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		stops = append(stops, func() error {
+			rpprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if c.Trace != "" {
+		f, err := os.Create(c.Trace)
+		if err != nil {
+			return nil, err
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if c.Block != "" {
+		runtime.SetBlockProfileRate(1)
+		stops = append(stops, func() error {
+			return writeProfile("block", c.Block)
+		})
+	}
+
+	if c.Mutex != "" {
+		runtime.SetMutexProfileFraction(1)
+		stops = append(stops, func() error {
+			return writeProfile("mutex", c.Mutex)
+		})
+	}
+
+	if c.Heap != "" {
+		stops = append(stops, func() error {
+			runtime.GC() // get up-to-date statistics
+			return writeProfile("heap", c.Heap)
+		})
+	}
+
+	return stopAll, nil
+}
+
+// writeProfile writes the named runtime profile to path
+func writeProfile(name, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := rpprof.Lookup(name).WriteTo(f, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// serve starts the live pprof listener, binding to localhost when addr has
+// no host so profiles are not exposed on other interfaces by accident
+func serve(addr string) (func() error, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("-pprof-http: %w", err)
+	}
+	if host == "" {
+		host = "localhost"
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	fmt.Fprintf(os.Stderr, "pprof: serving http://%s/debug/pprof/\n", listener.Addr())
+
+	return server.Close, nil
+}
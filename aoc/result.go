@@ -0,0 +1,189 @@
+package aoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"advent-of-code-2025/internal/parse"
+	"advent-of-code-2025/internal/timing"
+)
+
+// Format selects how a Result is written. It implements flag.Value.
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+func (f *Format) String() string {
+	return string(*f)
+}
+
+func (f *Format) Set(value string) error {
+	switch Format(value) {
+	case FormatText, FormatJSON:
+		*f = Format(value)
+		return nil
+	}
+	return fmt.Errorf("unknown format %q (want text or json)", value)
+}
+
+// PartResult is the outcome of running a single part of a Solver.
+type PartResult struct {
+	Answer   any
+	Err      error
+	Elapsed  time.Duration
+	Expected string // recorded answer to check against, if any
+}
+
+// String renders the answer, or "-" when the part is unsolved or failed.
+func (r PartResult) String() string {
+	if r.Err != nil {
+		return "-"
+	}
+	return fmt.Sprint(r.Answer)
+}
+
+// Failed reports whether the part returned an error other than ErrUnsolved.
+func (r PartResult) Failed() bool {
+	return r.Err != nil && !errors.Is(r.Err, ErrUnsolved)
+}
+
+// Check is "PASS" or "FAIL" when the part has a recorded answer, otherwise "".
+func (r PartResult) Check() string {
+	switch {
+	case r.Expected == "":
+		return ""
+	case r.Err == nil && fmt.Sprint(r.Answer) == r.Expected:
+		return "PASS"
+	default:
+		return "FAIL"
+	}
+}
+
+// Result is the outcome of running both parts of a day's solver against one
+// input. Every day reports through it, in text or as JSON.
+type Result struct {
+	Day         int
+	Algorithm   string
+	InputSHA256 string
+	Parts       [2]PartResult
+}
+
+// Run executes both parts of the solver against the input, timing each one.
+func Run(day int, s Solver, input []byte) Result {
+	sum := sha256.Sum256(input)
+	result := Result{Day: day, InputSHA256: hex.EncodeToString(sum[:])}
+	if namer, ok := s.(AlgorithmNamer); ok {
+		result.Algorithm = namer.Algorithm()
+	}
+
+	for i, part := range []func([]byte) (any, error){s.Part1, s.Part2} {
+		answer, elapsed, err := timing.Call(func() (any, error) { return part(input) })
+		result.Parts[i] = PartResult{Answer: answer, Err: err, Elapsed: elapsed}
+	}
+	return result
+}
+
+// Elapsed is the total time spent in both parts.
+func (r Result) Elapsed() time.Duration {
+	return r.Parts[0].Elapsed + r.Parts[1].Elapsed
+}
+
+// Verify records the expected answers that each part is checked against;
+// an empty string leaves that part unchecked.
+func (r *Result) Verify(expected [2]string) {
+	for i := range r.Parts {
+		r.Parts[i].Expected = expected[i]
+	}
+}
+
+// ParseError returns the error of the first part that could not parse the
+// input, or nil. Its position lets callers report it against the input file.
+func (r Result) ParseError() *parse.Error {
+	for _, p := range r.Parts {
+		var err *parse.Error
+		if errors.As(p.Err, &err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Failed reports whether either part returned an error other than
+// ErrUnsolved, or did not match its recorded answer.
+func (r Result) Failed() bool {
+	for _, p := range r.Parts {
+		if p.Failed() || p.Check() == "FAIL" {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON encodes the result as
+// {day, part1, part2, elapsed_ns, algorithm, input_sha256}, with a null answer
+// for a part that is unsolved or failed and an empty algorithm for a solver
+// that doesn't name one. Verified results also carry part1_check and
+// part2_check.
+func (r Result) MarshalJSON() ([]byte, error) {
+	answer := func(p PartResult) any {
+		if p.Err != nil {
+			return nil
+		}
+		return p.Answer
+	}
+	return json.Marshal(struct {
+		Day         int    `json:"day"`
+		Part1       any    `json:"part1"`
+		Part2       any    `json:"part2"`
+		ElapsedNS   int64  `json:"elapsed_ns"`
+		Algorithm   string `json:"algorithm"`
+		InputSHA256 string `json:"input_sha256"`
+		Part1Check  string `json:"part1_check,omitempty"`
+		Part2Check  string `json:"part2_check,omitempty"`
+	}{
+		Day:         r.Day,
+		Part1:       answer(r.Parts[0]),
+		Part2:       answer(r.Parts[1]),
+		ElapsedNS:   r.Elapsed().Nanoseconds(),
+		Algorithm:   r.Algorithm,
+		InputSHA256: r.InputSHA256,
+		Part1Check:  r.Parts[0].Check(),
+		Part2Check:  r.Parts[1].Check(),
+	})
+}
+
+// Write prints the result in the given format: a line per part for text, or
+// a single JSON object followed by a newline.
+func (r Result) Write(w io.Writer, format Format) error {
+	if format == FormatJSON {
+		return json.NewEncoder(w).Encode(r)
+	}
+
+	if r.Algorithm != "" {
+		fmt.Fprintf(w, "Algorithm: %s\n", r.Algorithm)
+	}
+	for i, p := range r.Parts {
+		if p.Failed() {
+			fmt.Fprintf(w, "Part %d: error: %v", i+1, p.Err)
+		} else {
+			fmt.Fprintf(w, "Part %d: %s", i+1, p)
+		}
+		switch p.Check() {
+		case "PASS":
+			fmt.Fprint(w, " PASS")
+		case "FAIL":
+			fmt.Fprintf(w, " FAIL (want %s)", p.Expected)
+		}
+		fmt.Fprintln(w)
+	}
+	_, err := fmt.Fprintf(w, "Execution time: %s\n", r.Elapsed())
+	return err
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"advent-of-code-2025/aoc"
+)
+
+// benchHistoryFile is where bench records its runs, in the repository root
+const benchHistoryFile = "bench_history.json"
+
+// BenchRun is one invocation of the bench command.
+type BenchRun struct {
+	Time    time.Time    `json:"time"`
+	Runs    int          `json:"runs"`
+	Entries []BenchEntry `json:"entries"`
+}
+
+// BenchEntry holds the timings of one part of one solver variant.
+type BenchEntry struct {
+	Day         int    `json:"day"`
+	Algorithm   string `json:"algorithm"`
+	Part        int    `json:"part"`
+	InputSHA256 string `json:"input_sha256"`
+	MedianNS    int64  `json:"median_ns"`
+	P95NS       int64  `json:"p95_ns"`
+}
+
+// sameBenchmark reports whether two entries time the same solver on the same input
+func (e BenchEntry) sameBenchmark(other BenchEntry) bool {
+	return e.Day == other.Day && e.Algorithm == other.Algorithm && e.Part == other.Part && e.InputSHA256 == other.InputSHA256
+}
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	root := fs.String("root", "", "repository root containing the day directories (default: found from the working directory)")
+	runs := fs.Int("n", 10, "number of times to run each solver")
+	threshold := fs.Float64("threshold", 0.10, "flag a regression when the median slows down by more than this fraction")
+	historyPath := fs.String("history", "", "history `file` to compare against and record to (default: "+benchHistoryFile+" in the repository root)")
+	var input aoc.Input
+	input.RegisterFlags(fs)
+	args = parseFlags(fs, args)
+
+	if len(args) != 1 {
+		return errors.New("expected a day number or \"all\"")
+	}
+	if *runs < 1 {
+		return errors.New("-n must be at least 1")
+	}
+	days, err := selectDays(args[0])
+	if err != nil {
+		return err
+	}
+	if len(days) > 1 && !input.Sample && input.Name() != aoc.DefaultInput {
+		return errors.New("-input can only be used when benchmarking a single day")
+	}
+	if *root == "" {
+		if *root, err = findRoot(); err != nil {
+			return err
+		}
+	}
+	if *historyPath == "" {
+		*historyPath = filepath.Join(*root, benchHistoryFile)
+	}
+
+	history, err := loadBenchHistory(*historyPath)
+	if err != nil {
+		return err
+	}
+
+	current := BenchRun{Time: time.Now().UTC(), Runs: *runs}
+	for _, day := range days {
+		data, err := input.Load(filepath.Join(*root, fmt.Sprintf("%02d", day)))
+		if err != nil {
+			return fmt.Errorf("day %02d: %w", day, err)
+		}
+
+		solverVariants, ok := variants[day]
+		if !ok {
+			solverVariants = []aoc.Solver{solvers[day]}
+		}
+		for _, solver := range solverVariants {
+			entries, err := benchSolver(day, solver, data, *runs)
+			if err != nil {
+				return err
+			}
+			current.Entries = append(current.Entries, entries...)
+		}
+	}
+
+	regressions := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Day\tAlgorithm\tPart\tMedian\tP95\tPrevious\tChange\t")
+	for _, entry := range current.Entries {
+		previous, found := previousEntry(history, entry)
+		change, status := "", ""
+		if found {
+			delta := float64(entry.MedianNS-previous.MedianNS) / float64(previous.MedianNS)
+			change = fmt.Sprintf("%+.1f%%", delta*100)
+			switch {
+			case delta > *threshold:
+				status = "REGRESSION"
+				regressions++
+			case delta < -*threshold:
+				status = "improved"
+			}
+		}
+
+		prevMedian := "-"
+		if found {
+			prevMedian = time.Duration(previous.MedianNS).String()
+		}
+		fmt.Fprintf(w, "%02d\t%s\tPart %d\t%s\t%s\t%s\t%s\t%s\n", entry.Day, entry.Algorithm, entry.Part,
+			time.Duration(entry.MedianNS), time.Duration(entry.P95NS), prevMedian, change, status)
+	}
+	w.Flush()
+
+	history = append(history, current)
+	if err := saveBenchHistory(*historyPath, history); err != nil {
+		return err
+	}
+	fmt.Printf("Recorded %d runs of %d benchmarks in %s\n", *runs, len(current.Entries), *historyPath)
+
+	if regressions > 0 {
+		return fmt.Errorf("%d benchmarks regressed by more than %.0f%%", regressions, *threshold*100)
+	}
+	return nil
+}
+
+// benchSolver runs both parts of the solver n times, returning the timings of
+// each part that produced an answer
+func benchSolver(day int, solver aoc.Solver, data []byte, n int) ([]BenchEntry, error) {
+	timings := [2][]time.Duration{}
+	var result aoc.Result
+	for range n {
+		result = aoc.Run(day, solver, data)
+		for i, p := range result.Parts {
+			if p.Failed() {
+				return nil, fmt.Errorf("day %02d part %d: %w", day, i+1, p.Err)
+			}
+			timings[i] = append(timings[i], p.Elapsed)
+		}
+	}
+
+	algorithm := result.Algorithm
+	if algorithm == "" {
+		algorithm = "default"
+	}
+
+	var entries []BenchEntry
+	for i, p := range result.Parts {
+		if errors.Is(p.Err, aoc.ErrUnsolved) {
+			continue
+		}
+		entries = append(entries, BenchEntry{
+			Day:         day,
+			Algorithm:   algorithm,
+			Part:        i + 1,
+			InputSHA256: result.InputSHA256,
+			MedianNS:    percentile(timings[i], 50).Nanoseconds(),
+			P95NS:       percentile(timings[i], 95).Nanoseconds(),
+		})
+	}
+	return entries, nil
+}
+
+// percentile returns the nearest-rank p-th percentile of the durations
+func percentile(durations []time.Duration, p int) time.Duration {
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// previousEntry finds the most recent recorded timing of the same benchmark
+func previousEntry(history []BenchRun, entry BenchEntry) (BenchEntry, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		for _, previous := range history[i].Entries {
+			if previous.sameBenchmark(entry) {
+				return previous, true
+			}
+		}
+	}
+	return BenchEntry{}, false
+}
+
+func loadBenchHistory(path string) ([]BenchRun, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var history []BenchRun
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return history, nil
+}
+
+func saveBenchHistory(path string, history []BenchRun) error {
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"advent-of-code-2025/aoc"
+	"advent-of-code-2025/aoc/fetch"
+)
+
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	root := fs.String("root", "", "repository root containing the day directories (default: found from the working directory)")
+	baseURL := fs.String("base-url", os.Getenv(fetch.BaseURLEnv), "website to download from (default "+fetch.DefaultBaseURL+", or $"+fetch.BaseURLEnv+")")
+	args = parseFlags(fs, args)
+
+	if len(args) != 1 {
+		return errors.New("expected a day number")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("invalid day %q", args[0])
+	}
+	if *root == "" {
+		if *root, err = findRoot(); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(*root, fmt.Sprintf("%02d", day), aoc.DefaultInput)
+	if fetch.Cached(path) {
+		fmt.Fprintf(os.Stderr, "day %02d: %s is already cached, not downloading again\n", day, path)
+		return nil
+	}
+
+	session, err := fetch.LoadSession()
+	if err != nil {
+		return err
+	}
+
+	client := &fetch.Client{BaseURL: *baseURL, Session: session}
+	if err := client.Download(context.Background(), day, path); err != nil {
+		return err
+	}
+
+	fmt.Printf("Downloaded day %02d input to %s\n", day, path)
+	return nil
+}
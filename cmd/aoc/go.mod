@@ -0,0 +1,37 @@
+module advent-of-code-2025/cmd/aoc
+
+go 1.24.2
+
+require (
+	advent-of-code-2025/01 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/02 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/03 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/04 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/05 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/06 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/07 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/08 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/09 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/10 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/11 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/12 v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/aoc v0.0.0-00010101000000-000000000000
+	advent-of-code-2025/internal v0.0.0-00010101000000-000000000000
+)
+
+replace (
+	advent-of-code-2025/01 => ../../01
+	advent-of-code-2025/02 => ../../02
+	advent-of-code-2025/03 => ../../03
+	advent-of-code-2025/04 => ../../04
+	advent-of-code-2025/05 => ../../05
+	advent-of-code-2025/06 => ../../06
+	advent-of-code-2025/07 => ../../07
+	advent-of-code-2025/08 => ../../08
+	advent-of-code-2025/09 => ../../09
+	advent-of-code-2025/10 => ../../10
+	advent-of-code-2025/11 => ../../11
+	advent-of-code-2025/12 => ../../12
+	advent-of-code-2025/aoc => ../../aoc
+	advent-of-code-2025/internal => ../../internal
+)
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"advent-of-code-2025/aoc"
+	"advent-of-code-2025/aoc/profile"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  run [flags] <day|all>    run one day's solver, or every registered day, against its input,
+                           checking answers recorded in the day's answers.txt
+  fetch [flags] <day>      download a day's puzzle input into its input.txt, unless already cached
+                           (session cookie from $AOC_SESSION or the aoc/session user config file)
+  new [flags] <day>        scaffold a day directory from templates (-templates <dir> to override
+                           them, -editor <command> to open the solver) and register it with the runner
+  bench [flags] <day|all>  time every solver variant over -n runs, record the median and
+                           p95 to bench_history.json and flag regressions against the last run
+                           (-sample for each day's sample.txt, -input <file> or - for a single day)
+
+Flags may come before or after the day, as in "aoc run -sample 5" or "aoc run 5 -sample".
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(os.Args[2:])
+	case "fetch":
+		err = fetchCommand(os.Args[2:])
+	case "new":
+		err = newCommand(os.Args[2:])
+	case "bench":
+		err = benchCommand(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aoc %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func runCommand(args []string) (err error) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	root := fs.String("root", "", "repository root containing the day directories (default: found from the working directory)")
+	var input aoc.Input
+	input.RegisterFlags(fs)
+	format := aoc.FormatText
+	fs.Var(&format, "format", "output `format`: text for a summary table, or json for one object per day")
+	var profiling profile.Config
+	profiling.RegisterFlags(fs)
+	args = parseFlags(fs, args)
+
+	if len(args) != 1 {
+		return errors.New("expected a day number or \"all\"")
+	}
+	days, err := selectDays(args[0])
+	if err != nil {
+		return err
+	}
+	if len(days) > 1 && !input.Sample && input.Name() != aoc.DefaultInput {
+		return errors.New("-input can only be used when running a single day")
+	}
+
+	if *root == "" {
+		if *root, err = findRoot(); err != nil {
+			return err
+		}
+	}
+
+	stop, err := profiling.Start()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if stopErr := stop(); err == nil {
+			err = stopErr
+		}
+	}()
+
+	summary := make([]aoc.Result, 0, len(days))
+	failed := false
+	startTime := time.Now()
+
+	for _, day := range days {
+		dir := filepath.Join(*root, fmt.Sprintf("%02d", day))
+		data, err := input.Load(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+			failed = true
+			continue
+		}
+		expected, verify, err := aoc.LoadAnswers(input.Resolve(dir))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "day %02d: %v\n", day, err)
+			failed = true
+			continue
+		}
+
+		result := aoc.Run(day, solvers[day], data)
+		if verify {
+			result.Verify(expected)
+		}
+		parseErr := result.ParseError()
+		if parseErr != nil {
+			fmt.Fprintf(os.Stderr, "%s:%v\n", input.Source(dir), parseErr)
+		}
+		for i, p := range result.Parts {
+			if p.Failed() && parseErr == nil {
+				fmt.Fprintf(os.Stderr, "day %02d part %d: %v\n", day, i+1, p.Err)
+			} else if p.Check() == "FAIL" {
+				fmt.Fprintf(os.Stderr, "day %02d part %d: got %s, want %s\n", day, i+1, p, p.Expected)
+			}
+		}
+		failed = failed || result.Failed()
+		if format == aoc.FormatJSON {
+			if err := result.Write(os.Stdout, format); err != nil {
+				return err
+			}
+		}
+		summary = append(summary, result)
+	}
+
+	if format == aoc.FormatText {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(w, "Day\tPart 1\tTime\tPart 2\tTime\tCheck\t")
+		for _, r := range summary {
+			fmt.Fprintf(w, "%02d\t%s\t%s\t%s\t%s\t%s\t\n", r.Day,
+				r.Parts[0], elapsed(r.Parts[0]), r.Parts[1], elapsed(r.Parts[1]), check(r))
+		}
+		w.Flush()
+		fmt.Printf("Execution time: %s\n", time.Since(startTime))
+	}
+
+	if failed {
+		return errors.New("one or more days failed")
+	}
+	return nil
+}
+
+// parseFlags parses args with fs, allowing flags after the positional
+// arguments as well as before them, and returns the positional arguments.
+// Everything after a "--" is positional.
+func parseFlags(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional
+		}
+		if read := len(args) - len(rest); read > 0 && args[read-1] == "--" {
+			return append(positional, rest...)
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// elapsed renders the time a part took, or "-" when it produced no answer
+func elapsed(r aoc.PartResult) string {
+	if r.Err != nil {
+		return "-"
+	}
+	return r.Elapsed.Round(time.Microsecond).String()
+}
+
+// check summarises a day's verification: FAIL if any part mismatched, PASS
+// if every recorded answer matched, or "-" when nothing was recorded
+func check(r aoc.Result) string {
+	status := "-"
+	for _, p := range r.Parts {
+		switch p.Check() {
+		case "FAIL":
+			return "FAIL"
+		case "PASS":
+			status = "PASS"
+		}
+	}
+	return status
+}
+
+// selectDays turns the run argument into the list of registered days to run
+func selectDays(arg string) ([]int, error) {
+	if arg == "all" {
+		days := make([]int, 0, len(solvers))
+		for day := range solvers {
+			days = append(days, day)
+		}
+		slices.Sort(days)
+		return days, nil
+	}
+
+	day, err := strconv.Atoi(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", arg)
+	}
+	if _, ok := solvers[day]; !ok {
+		return nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+	return []int{day}, nil
+}
+
+// findRoot walks up from the working directory to the repository root, which
+// is recognised by the runner module it contains
+func findRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "cmd", "aoc", "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("repository root not found; use -root")
+		}
+		dir = parent
+	}
+}
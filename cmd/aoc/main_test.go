@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"slices"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		args       []string
+		sample     bool
+		positional []string
+	}{
+		{[]string{"5"}, false, []string{"5"}},
+		{[]string{"-sample", "5"}, true, []string{"5"}},
+		{[]string{"5", "-sample"}, true, []string{"5"}},
+		{[]string{"5", "6", "-sample"}, true, []string{"5", "6"}},
+		{[]string{"5", "--", "-sample"}, false, []string{"5", "-sample"}},
+		{[]string{"--", "-5"}, false, []string{"-5"}},
+	}
+
+	for _, tt := range tests {
+		fs := flag.NewFlagSet("run", flag.ContinueOnError)
+		sample := fs.Bool("sample", false, "")
+		positional := parseFlags(fs, tt.args)
+		if *sample != tt.sample || !slices.Equal(positional, tt.positional) {
+			t.Errorf("parseFlags(%q) = %q with -sample=%t, want %q with -sample=%t", tt.args, positional, *sample, tt.positional, tt.sample)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// dayFiles maps each template to the file it renders, relative to the day
+// directory; Go sources are gofmt'ed after rendering
+var dayFiles = []struct{ template, path string }{
+	{"go.mod.tmpl", "go.mod"},
+	{"main.go.tmpl", "{{.Package}}.go"},
+	{"solver.go.tmpl", "{{.Package}}/{{.Package}}.go"},
+	{"solver_test.go.tmpl", "{{.Package}}/{{.Package}}_test.go"},
+	{"sample.txt.tmpl", "sample.txt"},
+	{"input.txt.tmpl", "input.txt"},
+}
+
+// dayData is what the templates are rendered with
+type dayData struct {
+	Day     int    // 7
+	Dir     string // "07", the day directory and module suffix
+	Package string // "day07", the solver package
+}
+
+func newDayData(day int) dayData {
+	dir := fmt.Sprintf("%02d", day)
+	return dayData{Day: day, Dir: dir, Package: "day" + dir}
+}
+
+func newCommand(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	root := fs.String("root", "", "repository root containing the day directories (default: found from the working directory)")
+	templates := fs.String("templates", "", "`dir` of templates overriding the built-in ones by name ("+templateNames()+")")
+	editor := fs.String("editor", "", "`command` to open the new solver with, e.g. \"code -r\" (default: none)")
+	args = parseFlags(fs, args)
+
+	if len(args) != 1 {
+		return errors.New("expected a day number")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("invalid day %q", args[0])
+	}
+	if *root == "" {
+		if *root, err = findRoot(); err != nil {
+			return err
+		}
+	}
+
+	data := newDayData(day)
+	dir := filepath.Join(*root, data.Dir)
+	if err := renderDay(dir, data, *templates); err != nil {
+		return err
+	}
+	if err := registerDay(*root, data); err != nil {
+		return fmt.Errorf("day %s created but not registered: %w", data.Dir, err)
+	}
+	fmt.Printf("Created day %s in %s\n", data.Dir, dir)
+
+	if *editor == "" {
+		return nil
+	}
+	command := strings.Fields(*editor)
+	cmd := exec.Command(command[0], append(command[1:], filepath.Join(dir, data.Package, data.Package+".go"))...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+func templateNames() string {
+	names := make([]string, len(dayFiles))
+	for i, f := range dayFiles {
+		names[i] = f.template
+	}
+	return strings.Join(names, ", ")
+}
+
+// renderDay creates dir and renders every day file into it, refusing to
+// touch a directory that already exists. Templates found in override take
+// precedence over the built-in ones.
+func renderDay(dir string, data dayData, override string) error {
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists, not overwriting it", dir)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	type file struct {
+		path    string
+		content []byte
+	}
+	files := make([]file, 0, len(dayFiles))
+	for _, f := range dayFiles {
+		path, err := execute(f.path, f.path, data)
+		if err != nil {
+			return err
+		}
+		text, err := loadTemplate(f.template, override)
+		if err != nil {
+			return err
+		}
+		content, err := execute(f.template, string(text), data)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(string(path), ".go") {
+			if content, err = format.Source(content); err != nil {
+				return fmt.Errorf("%s: %w", f.template, err)
+			}
+		}
+		files = append(files, file{filepath.Join(dir, filepath.FromSlash(string(path))), content})
+	}
+
+	// only write once everything rendered, so a broken template leaves no
+	// half-made day behind
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(f.path, f.content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadTemplate(name, override string) ([]byte, error) {
+	if override != "" {
+		text, err := os.ReadFile(filepath.Join(override, name))
+		if !errors.Is(err, fs.ErrNotExist) {
+			return text, err
+		}
+	}
+	return defaultTemplates.ReadFile("templates/" + name)
+}
+
+func execute(name, text string, data dayData) ([]byte, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// registerDay links a new day into the runner: its package goes into the
+// solvers map in registry.go, its module into the runner's go.mod, and the
+// module into the go.work workspace
+func registerDay(root string, data dayData) error {
+	runnerDir := filepath.Join(root, "cmd", "aoc")
+	path := filepath.Join(runnerDir, "registry.go")
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if src, err = addToRegistry(src, data); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		return err
+	}
+
+	module := "advent-of-code-2025/" + data.Dir
+	if err := goCommand(runnerDir, "mod", "edit",
+		"-require="+module+"@v0.0.0-00010101000000-000000000000",
+		"-replace="+module+"=../../"+data.Dir); err != nil {
+		return err
+	}
+	return goCommand(root, "work", "use", "./"+data.Dir)
+}
+
+func goCommand(dir string, args ...string) error {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// addToRegistry returns the registry source with the day's package imported
+// and its solver added to the solvers map, keeping the entries in day order
+func addToRegistry(src []byte, data dayData) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "registry.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var imports *ast.GenDecl
+	var solvers *ast.CompositeLit
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gen.Tok {
+		case token.IMPORT:
+			imports = gen
+		case token.VAR:
+			for _, spec := range gen.Specs {
+				value := spec.(*ast.ValueSpec)
+				if len(value.Names) == 1 && value.Names[0].Name == "solvers" && len(value.Values) == 1 {
+					solvers, _ = value.Values[0].(*ast.CompositeLit)
+				}
+			}
+		}
+	}
+	if imports == nil || !imports.Rparen.IsValid() || solvers == nil {
+		return nil, errors.New("registry.go has no import block or solvers map")
+	}
+
+	entry := solvers.Rbrace
+	for _, elt := range solvers.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(key.Value)
+		if err != nil {
+			continue
+		}
+		if n == data.Day {
+			return nil, fmt.Errorf("day %d is already registered", data.Day)
+		}
+		if n > data.Day {
+			entry = fset.File(elt.Pos()).LineStart(fset.Position(elt.Pos()).Line)
+			break
+		}
+	}
+
+	// the import block comes before the map, so splice the entry in first to
+	// keep the import offset valid; gofmt then sorts the import and aligns
+	// the map
+	out := slices.Clone(src)
+	out = slices.Insert(out, fset.Position(entry).Offset, []byte(fmt.Sprintf("\t%d: %s.Solver{},\n", data.Day, data.Package))...)
+	out = slices.Insert(out, fset.Position(imports.Rparen).Offset, []byte(fmt.Sprintf("\t%q\n", "advent-of-code-2025/"+data.Dir+"/"+data.Package))...)
+	return format.Source(out)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const registry = `package main
+
+import (
+	"advent-of-code-2025/01/day01"
+	"advent-of-code-2025/03/day03"
+	"advent-of-code-2025/aoc"
+)
+
+var solvers = map[int]aoc.Solver{
+	1: day01.Solver{},
+	3: day03.Solver{},
+}
+`
+
+func TestAddToRegistry(t *testing.T) {
+	got, err := addToRegistry([]byte(registry), newDayData(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"\t\"advent-of-code-2025/01/day01\"\n\t\"advent-of-code-2025/02/day02\"\n\t\"advent-of-code-2025/03/day03\"\n",
+		"\t1: day01.Solver{},\n\t2: day02.Solver{},\n\t3: day03.Solver{},\n}",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("registry missing %q:\n%s", want, got)
+		}
+	}
+
+	got, err = addToRegistry([]byte(registry), newDayData(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\t3:  day03.Solver{},\n\t10: day10.Solver{},\n}"; !strings.Contains(string(got), want) {
+		t.Errorf("registry missing %q:\n%s", want, got)
+	}
+
+	if _, err := addToRegistry([]byte(registry), newDayData(3)); err == nil {
+		t.Error("registering day 3 twice: want error")
+	}
+}
+
+func TestRenderDay(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "07")
+	override := t.TempDir()
+	if err := os.WriteFile(filepath.Join(override, "sample.txt.tmpl"), []byte("day {{.Day}}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := renderDay(dir, newDayData(7), override); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]string{
+		"go.mod":              "module advent-of-code-2025/07\n",
+		"day07.go":            "aoc.Main(7, day07.Solver{})",
+		"day07/day07.go":      "package day07\n",
+		"day07/day07_test.go": "package day07\n",
+		"sample.txt":          "day 7\n",
+		"input.txt":           "",
+	} {
+		got, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !strings.Contains(string(got), want) {
+			t.Errorf("%s missing %q:\n%s", path, want, got)
+		}
+	}
+
+	if err := renderDay(dir, newDayData(7), ""); err == nil {
+		t.Error("rendering over an existing day: want error")
+	}
+}
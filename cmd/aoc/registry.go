@@ -0,0 +1,40 @@
+package main
+
+import (
+	"advent-of-code-2025/01/day01"
+	"advent-of-code-2025/02/day02"
+	"advent-of-code-2025/03/day03"
+	"advent-of-code-2025/04/day04"
+	"advent-of-code-2025/05/day05"
+	"advent-of-code-2025/06/day06"
+	"advent-of-code-2025/07/day07"
+	"advent-of-code-2025/08/day08"
+	"advent-of-code-2025/09/day09"
+	"advent-of-code-2025/10/day10"
+	"advent-of-code-2025/11/day11"
+	"advent-of-code-2025/12/day12"
+	"advent-of-code-2025/aoc"
+)
+
+// solvers maps each day number to the solver linked into the runner
+var solvers = map[int]aoc.Solver{
+	1:  day01.Solver{},
+	2:  day02.Solver{PatternGeneration: true},
+	3:  day03.Solver{},
+	4:  day04.Solver{},
+	5:  day05.Solver{},
+	6:  day06.Solver{},
+	7:  day07.Solver{},
+	8:  day08.Solver{},
+	9:  day09.Solver{},
+	10: day10.Solver{},
+	11: day11.Solver{},
+	12: day12.Solver{},
+}
+
+// variants lists every algorithm of the days that carry more than one, so
+// they can be benchmarked against each other
+var variants = map[int][]aoc.Solver{
+	2:  {day02.Solver{}, day02.Solver{Parallel: true}, day02.Solver{PatternGeneration: true}, day02.Solver{ClosedForm: true}},
+	10: {day10.Solver{Joltage: day10.Partition}, day10.Solver{Joltage: day10.CSP}, day10.Solver{Joltage: day10.MILP}},
+}
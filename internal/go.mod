@@ -0,0 +1,3 @@
+module advent-of-code-2025/internal
+
+go 1.24.2
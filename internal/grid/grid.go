@@ -0,0 +1,105 @@
+// Package grid parses character maps into rectangular grids of cells and
+// walks their neighbourhoods.
+package grid
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"advent-of-code-2025/internal/parse"
+)
+
+// Point is a cell position, or an offset between cells.
+type Point struct{ Row, Col int }
+
+// Add returns p moved by the offset d.
+func (p Point) Add(d Point) Point {
+	return Point{p.Row + d.Row, p.Col + d.Col}
+}
+
+// Orthogonal are the offsets of the four cells sharing an edge with a cell.
+var Orthogonal = []Point{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+
+// Adjacent are the offsets of the eight cells surrounding a cell.
+var Adjacent = []Point{
+	{-1, -1}, {-1, 0}, {-1, 1},
+	{0, -1}, {0, 1},
+	{1, -1}, {1, 0}, {1, 1},
+}
+
+// Grid is a rectangular map of cells indexed [row][col].
+type Grid [][]byte
+
+// Parse reads one row per line, requiring every row to be the same width
+// and, unless cells is empty, to hold only the characters in cells.
+func Parse(r io.Reader, cells string) (Grid, error) {
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := make(Grid, len(lines))
+	for i, line := range lines {
+		if len(line.Text) != len(lines[0].Text) {
+			return nil, line.ErrorAt(min(len(line.Text), len(lines[0].Text)),
+				"row is %d cells wide, want %d", len(line.Text), len(lines[0].Text))
+		}
+		if cells != "" {
+			if col := strings.IndexFunc(line.Text, func(c rune) bool { return !strings.ContainsRune(cells, c) }); col >= 0 {
+				return nil, line.ErrorAt(col, "unexpected %q, want one of %q", line.Text[col], cells)
+			}
+		}
+		g[i] = []byte(line.Text)
+	}
+	return g, nil
+}
+
+// Height is the number of rows.
+func (g Grid) Height() int { return len(g) }
+
+// Width is the number of columns.
+func (g Grid) Width() int {
+	if len(g) == 0 {
+		return 0
+	}
+	return len(g[0])
+}
+
+// In reports whether p lies inside the grid.
+func (g Grid) In(p Point) bool {
+	return p.Row >= 0 && p.Row < g.Height() && p.Col >= 0 && p.Col < g.Width()
+}
+
+// At returns the cell at p, which must be inside the grid.
+func (g Grid) At(p Point) byte { return g[p.Row][p.Col] }
+
+// Set changes the cell at p, which must be inside the grid.
+func (g Grid) Set(p Point, cell byte) { g[p.Row][p.Col] = cell }
+
+// Find returns the first position of cell, scanning row by row.
+func (g Grid) Find(cell byte) (Point, bool) {
+	for row, cells := range g {
+		if col := bytes.IndexByte(cells, cell); col >= 0 {
+			return Point{row, col}, true
+		}
+	}
+	return Point{}, false
+}
+
+// Count returns how many of the cells at the given offsets from p, ignoring
+// any outside the grid, hold cell.
+func (g Grid) Count(p Point, offsets []Point, cell byte) int {
+	count := 0
+	for _, d := range offsets {
+		if q := p.Add(d); g.In(q) && g.At(q) == cell {
+			count++
+		}
+	}
+	return count
+}
+
+// String renders the grid back into lines.
+func (g Grid) String() string {
+	return string(bytes.Join(g, []byte("\n")))
+}
@@ -0,0 +1,40 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	g, err := Parse(strings.NewReader("..@\n@@.\n.@.\n"), ".@")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Height() != 3 || g.Width() != 3 {
+		t.Fatalf("size = %dx%d, want 3x3", g.Width(), g.Height())
+	}
+	if p, ok := g.Find('@'); !ok || p != (Point{0, 2}) {
+		t.Errorf("Find('@') = %v, %t, want {0 2}", p, ok)
+	}
+	if got := g.Count(Point{1, 1}, Adjacent, '@'); got != 3 {
+		t.Errorf("Count(1,1 Adjacent) = %d, want 3", got)
+	}
+	if got := g.Count(Point{0, 0}, Orthogonal, '@'); got != 1 {
+		t.Errorf("Count(0,0 Orthogonal) = %d, want 1", got)
+	}
+
+	g.Set(Point{0, 2}, '.')
+	if got, want := g.String(), "...\n@@.\n.@."; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+
+	for input, want := range map[string]string{
+		"...\n..\n":   "2:3: row is 2 cells wide, want 3",
+		"...\n.#.\n":  `2:2: unexpected '#', want one of ".@"`,
+		"...\n....\n": "2:4: row is 4 cells wide, want 3",
+	} {
+		if _, err := Parse(strings.NewReader(input), ".@"); err == nil || err.Error() != want {
+			t.Errorf("Parse(%q) error = %v, want %s", input, err, want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Package mathx holds the small numeric helpers the days kept re-declaring.
+// min and max are Go builtins and need no helper.
+package mathx
+
+// Signed is any type that can be negated.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Number is any integer or float type.
+type Number interface {
+	Signed | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Abs returns the absolute value of x.
+func Abs[T Signed](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Sum adds up values, widening each to the accumulator type R so that many
+// small values can be totalled without overflowing.
+func Sum[R, T Number](values []T) R {
+	var total R
+	for _, v := range values {
+		total += R(v)
+	}
+	return total
+}
@@ -0,0 +1,28 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAbs(t *testing.T) {
+	if got := Abs(-3); got != 3 {
+		t.Errorf("Abs(-3) = %d, want 3", got)
+	}
+	if got := Abs(int64(7)); got != 7 {
+		t.Errorf("Abs(7) = %d, want 7", got)
+	}
+	if got := Abs(-1.5); got != 1.5 {
+		t.Errorf("Abs(-1.5) = %v, want 1.5", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	values := []int32{math.MaxInt32, math.MaxInt32, 2}
+	if got, want := Sum[int64](values), int64(2*math.MaxInt32+2); got != want {
+		t.Errorf("Sum = %d, want %d", got, want)
+	}
+	if got := Sum[int]([]int(nil)); got != 0 {
+		t.Errorf("Sum(nil) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,187 @@
+// Package parse reads puzzle input line by line, returning errors that give
+// the line and column of whatever was wrong instead of panicking.
+package parse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Error is a problem at a position in the input. Lines and columns count
+// from 1, with columns in bytes.
+type Error struct {
+	Line, Col int
+	Msg       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Col, e.Msg)
+}
+
+// Line is a cursor over one line of input, so that whatever reads it can
+// report errors at the position it got to.
+type Line struct {
+	Num  int    // line number, from 1
+	Text string // the line without its line ending
+	Pos  int    // byte offset of the next unread character
+}
+
+// ReadLines reads every line of r, dropping line endings and any blank lines
+// at the end of the input.
+func ReadLines(r io.Reader) ([]Line, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	var lines []Line
+	for scanner.Scan() {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		lines = append(lines, Line{Num: len(lines) + 1, Text: text})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Text) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines, nil
+}
+
+// Split divides lines into the blocks separated by blank lines.
+func Split(lines []Line) [][]Line {
+	var blocks [][]Line
+	start := 0
+	for i, line := range lines {
+		if line.Text == "" {
+			if i > start {
+				blocks = append(blocks, lines[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(lines) {
+		blocks = append(blocks, lines[start:])
+	}
+	return blocks
+}
+
+// Errorf returns an *Error at the cursor.
+func (l *Line) Errorf(format string, args ...any) error {
+	return l.ErrorAt(l.Pos, format, args...)
+}
+
+// ErrorAt returns an *Error at byte offset pos of the line.
+func (l *Line) ErrorAt(pos int, format string, args ...any) error {
+	return &Error{Line: l.Num, Col: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Done reports whether the whole line has been read.
+func (l *Line) Done() bool {
+	return l.Pos >= len(l.Text)
+}
+
+// Peek returns the next character without reading it, or 0 at the end.
+func (l *Line) Peek() byte {
+	if l.Done() {
+		return 0
+	}
+	return l.Text[l.Pos]
+}
+
+// Accept reads the next character if it is c.
+func (l *Line) Accept(c byte) bool {
+	if l.Peek() != c || l.Done() {
+		return false
+	}
+	l.Pos++
+	return true
+}
+
+// Expect reads s, or fails if the line doesn't continue with it.
+func (l *Line) Expect(s string) error {
+	if !strings.HasPrefix(l.Text[l.Pos:], s) {
+		return l.Errorf("expected %q", s)
+	}
+	l.Pos += len(s)
+	return nil
+}
+
+// Until reads up to, but not including, the next sep or the end of the line.
+func (l *Line) Until(sep byte) string {
+	start := l.Pos
+	if i := strings.IndexByte(l.Text[start:], sep); i >= 0 {
+		l.Pos += i
+	} else {
+		l.Pos = len(l.Text)
+	}
+	return l.Text[start:l.Pos]
+}
+
+// End fails if anything is left unread.
+func (l *Line) End() error {
+	if !l.Done() {
+		return l.Errorf("unexpected %q", l.Text[l.Pos:])
+	}
+	return nil
+}
+
+// Int reads a base 10 int with an optional leading minus sign.
+func (l *Line) Int() (int, error) {
+	n, err := l.Int64()
+	return int(n), err
+}
+
+// Int64 reads a base 10 int64 with an optional leading minus sign.
+func (l *Line) Int64() (int64, error) {
+	start := l.Pos
+	l.Accept('-')
+	digits := l.digits()
+	if digits == "" {
+		return 0, l.Errorf("expected digit")
+	}
+	n, err := strconv.ParseInt(l.Text[start:l.Pos], 10, 64)
+	if err != nil {
+		return 0, l.ErrorAt(start, "number %s out of range", l.Text[start:l.Pos])
+	}
+	return n, nil
+}
+
+// Uint64 reads a base 10 uint64.
+func (l *Line) Uint64() (uint64, error) {
+	start := l.Pos
+	digits := l.digits()
+	if digits == "" {
+		return 0, l.Errorf("expected digit")
+	}
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, l.ErrorAt(start, "number %s out of range", digits)
+	}
+	return n, nil
+}
+
+// Ints reads a list of ints separated by sep, such as "1,2,3".
+func (l *Line) Ints(sep byte) ([]int, error) {
+	var values []int
+	for {
+		n, err := l.Int()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+		if !l.Accept(sep) {
+			return values, nil
+		}
+	}
+}
+
+// digits reads a run of decimal digits.
+func (l *Line) digits() string {
+	start := l.Pos
+	for !l.Done() && '0' <= l.Text[l.Pos] && l.Text[l.Pos] <= '9' {
+		l.Pos++
+	}
+	return l.Text[start:l.Pos]
+}
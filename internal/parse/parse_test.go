@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	lines, err := ReadLines(strings.NewReader("a\r\nb\n\nc\n\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var texts []string
+	for _, line := range lines {
+		texts = append(texts, line.Text)
+	}
+	if want := []string{"a", "b", "", "c"}; !slices.Equal(texts, want) {
+		t.Errorf("ReadLines = %q, want %q", texts, want)
+	}
+
+	blocks := Split(lines)
+	if len(blocks) != 2 || len(blocks[0]) != 2 || blocks[1][0].Num != 4 {
+		t.Errorf("Split = %v, want blocks of lines 1-2 and 4", blocks)
+	}
+}
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		text string
+		want []int
+		err  string
+	}{
+		{"1,2,-3", []int{1, 2, -3}, ""},
+		{"42", []int{42}, ""},
+		{"1,x,3", nil, "7:3: expected digit"},
+		{"1,,3", nil, "7:3: expected digit"},
+		{"1,2 ", nil, `7:4: unexpected " "`},
+		{"99999999999999999999", nil, "7:1: number 99999999999999999999 out of range"},
+	}
+
+	for _, tt := range tests {
+		line := Line{Num: 7, Text: tt.text}
+		got, err := line.Ints(',')
+		if err == nil {
+			err = line.End()
+		}
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("Ints(%q) error = %v, want %s", tt.text, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("Ints(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+
+	line := Line{Num: 2, Text: "abc: def"}
+	if name := line.Until(':'); name != "abc" {
+		t.Errorf("Until = %q, want abc", name)
+	}
+	if err := line.Expect(": "); err != nil {
+		t.Error(err)
+	}
+	if err := line.Expect("x"); err == nil || err.Error() != `2:6: expected "x"` {
+		t.Errorf("Expect error = %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+// Package timing measures how long solver work takes, for the run reports
+// and for flagging slow problems as they finish.
+package timing
+
+import "time"
+
+// Measure calls f and returns how long it took.
+func Measure(f func()) time.Duration {
+	start := time.Now()
+	f()
+	return time.Since(start)
+}
+
+// Call calls f and returns its result along with how long it took.
+func Call[T any](f func() (T, error)) (T, time.Duration, error) {
+	var result T
+	var err error
+	elapsed := Measure(func() { result, err = f() })
+	return result, elapsed, err
+}